@@ -83,6 +83,7 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_acm_certificate":                                          tableAwsAcmCertificate(ctx),
 			"aws_acmpca_certificate_authority":                             tableAwsAcmPcaCertificateAuthority(ctx),
 			"aws_amplify_app":                                              tableAwsAmplifyApp(ctx),
+			"aws_amplify_branch":                                           tableAwsAmplifyBranch(ctx),
 			"aws_api_gateway_api_key":                                      tableAwsAPIGatewayAPIKey(ctx),
 			"aws_api_gateway_authorizer":                                   tableAwsAPIGatewayAuthorizer(ctx),
 			"aws_api_gateway_domain_name":                                  tableAwsAPIGatewayDomainName(ctx),
@@ -95,6 +96,7 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_api_gatewayv2_integration":                                tableAwsAPIGatewayV2Integration(ctx),
 			"aws_api_gatewayv2_route":                                      tableAwsAPIGatewayV2Route(ctx),
 			"aws_api_gatewayv2_stage":                                      tableAwsAPIGatewayV2Stage(ctx),
+			"aws_app_runner_connection":                                    tableAwsAppRunnerConnection(ctx),
 			"aws_app_runner_service":                                       tableAwsAppRunnerService(ctx),
 			"aws_appautoscaling_policy":                                    tableAwsAppAutoScalingPolicy(ctx),
 			"aws_appautoscaling_target":                                    tableAwsAppAutoScalingTarget(ctx),
@@ -102,6 +104,8 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_appstream_fleet":                                          tableAwsAppStreamFleet(ctx),
 			"aws_appstream_image":                                          tableAwsAppStreamImage(ctx),
 			"aws_appsync_graphql_api":                                      tableAwsAppsyncGraphQLApi(ctx),
+			"aws_athena_named_query":                                       tableAwsAthenaNamedQuery(ctx),
+			"aws_athena_prepared_statement":                                tableAwsAthenaPreparedStatement(ctx),
 			"aws_athena_query_execution":                                   tableAwsAthenaQueryExecution(ctx),
 			"aws_athena_workgroup":                                         tableAwsAthenaWorkGroup(ctx),
 			"aws_auditmanager_assessment":                                  tableAwsAuditManagerAssessment(ctx),
@@ -119,6 +123,9 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_backup_selection":                                         tableAwsBackupSelection(ctx),
 			"aws_backup_vault":                                             tableAwsBackupVault(ctx),
 			"aws_backup_job":                                               tableAwsBackupJob(ctx),
+			"aws_batch_compute_environment":                                tableAwsBatchComputeEnvironment(ctx),
+			"aws_batch_job_definition":                                     tableAwsBatchJobDefinition(ctx),
+			"aws_batch_job_queue":                                          tableAwsBatchJobQueue(ctx),
 			"aws_cloudcontrol_resource":                                    tableAwsCloudControlResource(ctx),
 			"aws_cloudformation_stack":                                     tableAwsCloudFormationStack(ctx),
 			"aws_cloudformation_stack_resource":                            tableAwsCloudFormationStackResource(ctx),
@@ -126,6 +133,7 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_cloudfront_cache_policy":                                  tableAwsCloudFrontCachePolicy(ctx),
 			"aws_cloudfront_distribution":                                  tableAwsCloudFrontDistribution(ctx),
 			"aws_cloudfront_function":                                      tableAwsCloudFrontFunction(ctx),
+			"aws_cloudfront_key_group":                                     tableAwsCloudFrontKeyGroup(ctx),
 			"aws_cloudfront_origin_access_identity":                        tableAwsCloudFrontOriginAccessIdentity(ctx),
 			"aws_cloudfront_origin_request_policy":                         tableAwsCloudFrontOriginRequestPolicy(ctx),
 			"aws_cloudfront_response_headers_policy":                       tableAwsCloudFrontResponseHeadersPolicy(ctx),
@@ -138,6 +146,9 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_cloudtrail_trail":                                         tableAwsCloudtrailTrail(ctx),
 			"aws_cloudtrail_trail_event":                                   tableAwsCloudtrailTrailEvent(ctx),
 			"aws_cloudwatch_alarm":                                         tableAwsCloudWatchAlarm(ctx),
+			"aws_cloudwatch_anomaly_detector":                              tableAwsCloudWatchAnomalyDetector(ctx),
+			"aws_cloudwatch_dashboard":                                     tableAwsCloudWatchDashboard(ctx),
+			"aws_cloudwatch_insight_rule":                                  tableAwsCloudWatchInsightRule(ctx),
 			"aws_cloudwatch_log_event":                                     tableAwsCloudwatchLogEvent(ctx),
 			"aws_cloudwatch_log_group":                                     tableAwsCloudwatchLogGroup(ctx),
 			"aws_cloudwatch_log_metric_filter":                             tableAwsCloudwatchLogMetricFilter(ctx),
@@ -223,7 +234,9 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_ec2_capacity_reservation":                                 tableAwsEc2CapacityReservation(ctx),
 			"aws_ec2_classic_load_balancer":                                tableAwsEc2ClassicLoadBalancer(ctx),
 			"aws_ec2_client_vpn_endpoint":                                  tableAwsEC2ClientVPNEndpoint(ctx),
+			"aws_ec2_fleet":                                                tableAwsEc2Fleet(ctx),
 			"aws_ec2_gateway_load_balancer":                                tableAwsEc2GatewayLoadBalancer(ctx),
+			"aws_ec2_instance_connect_endpoint":                            tableAwsEc2InstanceConnectEndpoint(ctx),
 			"aws_ec2_load_balancer_listener_rule":                          tableAwsEc2ApplicationLoadBalancerListenerRule(ctx),
 			"aws_ec2_instance":                                             tableAwsEc2Instance(ctx),
 			"aws_ec2_instance_availability":                                tableAwsInstanceAvailability(ctx),
@@ -238,6 +251,8 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_ec2_load_balancer_listener":                               tableAwsEc2ApplicationLoadBalancerListener(ctx),
 			"aws_ec2_managed_prefix_list":                                  tableAwsEc2ManagedPrefixList(ctx),
 			"aws_ec2_managed_prefix_list_entry":                            tableAwsEc2ManagedPrefixListEntry(ctx),
+			"aws_ec2_network_insights_analysis":                            tableAwsEc2NetworkInsightsAnalysis(ctx),
+			"aws_ec2_network_insights_path":                                tableAwsEc2NetworkInsightsPath(ctx),
 			"aws_ec2_network_interface":                                    tableAwsEc2NetworkInterface(ctx),
 			"aws_ec2_network_load_balancer":                                tableAwsEc2NetworkLoadBalancer(ctx),
 			"aws_ec2_network_load_balancer_metric_net_flow_count":          tableAwsEc2NetworkLoadBalancerMetricNetFlowCount(ctx),
@@ -287,7 +302,10 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_elasticache_redis_metric_new_connections_hourly":          tableAwsElasticacheRedisMetricNewConnectionsHourly(ctx),
 			"aws_elasticache_replication_group":                            tableAwsElastiCacheReplicationGroup(ctx),
 			"aws_elasticache_reserved_cache_node":                          tableAwsElastiCacheReservedCacheNode(ctx),
+			"aws_elasticache_serverless_cache":                             tableAwsElastiCacheServerlessCache(ctx),
 			"aws_elasticache_subnet_group":                                 tableAwsElastiCacheSubnetGroup(ctx),
+			"aws_elasticache_user":                                         tableAwsElastiCacheUser(ctx),
+			"aws_elasticache_user_group":                                   tableAwsElastiCacheUserGroup(ctx),
 			"aws_elasticsearch_domain":                                     tableAwsElasticsearchDomain(ctx),
 			"aws_emr_block_public_access_configuration":                    tableAwsEmrBlockPublicAccessConfiguration(ctx),
 			"aws_emr_cluster":                                              tableAwsEmrCluster(ctx),
@@ -296,10 +314,14 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_emr_instance_fleet":                                       tableAwsEmrInstanceFleet(ctx),
 			"aws_emr_instance_group":                                       tableAwsEmrInstanceGroup(ctx),
 			"aws_emr_security_configuration":                               tableAwsEmrSecurityConfiguration(ctx),
+			"aws_emr_studio":                                               tableAwsEmrStudio(ctx),
+			"aws_eventbridge_api_destination":                              tableAwsEventBridgeApiDestination(ctx),
 			"aws_eventbridge_bus":                                          tableAwsEventBridgeBus(ctx),
+			"aws_eventbridge_connection":                                   tableAwsEventBridgeConnection(ctx),
 			"aws_eventbridge_rule":                                         tableAwsEventBridgeRule(ctx),
 			"aws_fms_app_list":                                             tableAwsFMSAppList(ctx),
 			"aws_fms_policy":                                               tableAwsFMSPolicy(ctx),
+			"aws_fsx_backup":                                               tableAwsFsxBackup(ctx),
 			"aws_fsx_file_system":                                          tableAwsFsxFileSystem(ctx),
 			"aws_glacier_vault":                                            tableAwsGlacierVault(ctx),
 			"aws_globalaccelerator_accelerator":                            tableAwsGlobalAcceleratorAccelerator(ctx),
@@ -313,7 +335,9 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_glue_data_quality_ruleset":                                tableAwsGlueDataQualityRuleset(ctx),
 			"aws_glue_dev_endpoint":                                        tableAwsGlueDevEndpoint(ctx),
 			"aws_glue_job":                                                 tableAwsGlueJob(ctx),
+			"aws_glue_ml_transform":                                        tableAwsGlueMLTransform(ctx),
 			"aws_glue_security_configuration":                              tableAwsGlueSecurityConfiguration(ctx),
+			"aws_glue_trigger":                                             tableAwsGlueTrigger(ctx),
 			"aws_guardduty_detector":                                       tableAwsGuardDutyDetector(ctx),
 			"aws_guardduty_filter":                                         tableAwsGuardDutyFilter(ctx),
 			"aws_guardduty_finding":                                        tableAwsGuardDutyFinding(ctx),
@@ -343,6 +367,8 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_identitystore_group":                                      tableAwsIdentityStoreGroup(ctx),
 			"aws_identitystore_group_membership":                           tableAwsIdentityStoreGroupMembership(ctx),
 			"aws_identitystore_user":                                       tableAwsIdentityStoreUser(ctx),
+			"aws_imagebuilder_component":                                   tableAwsImageBuilderComponent(ctx),
+			"aws_imagebuilder_image_pipeline":                              tableAwsImageBuilderImagePipeline(ctx),
 			"aws_inspector2_coverage":                                      tableAwsInspector2Coverage(ctx),
 			"aws_inspector2_coverage_statistics":                           tableAwsInspector2CoverageStatistics(ctx),
 			"aws_inspector2_finding":                                       tableAwsInspector2Finding(ctx),
@@ -364,8 +390,11 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_kinesis_video_stream":                                     tableAwsKinesisVideoStream(ctx),
 			"aws_kinesisanalyticsv2_application":                           tableAwsKinesisAnalyticsV2Application(ctx),
 			"aws_kms_alias":                                                tableAwsKmsAlias(ctx),
+			"aws_kms_grant":                                                tableAwsKmsGrant(ctx),
 			"aws_kms_key":                                                  tableAwsKmsKey(ctx),
 			"aws_kms_key_rotation":                                         tableAwsKmsKeyRotation(ctx),
+			"aws_lakeformation_permissions":                                tableAwsLakeFormationPermissions(ctx),
+			"aws_lakeformation_tag":                                        tableAwsLakeFormationTag(ctx),
 			"aws_lambda_alias":                                             tableAwsLambdaAlias(ctx),
 			"aws_lambda_event_source_mapping":                              tableAwsLambdaEventSourceMapping(ctx),
 			"aws_lambda_function":                                          tableAwsLambdaFunction(ctx),
@@ -376,13 +405,17 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_lambda_layer_version":                                     tableAwsLambdaLayerVersion(ctx),
 			"aws_lambda_version":                                           tableAwsLambdaVersion(ctx),
 			"aws_lightsail_bucket":                                         tableAwsLightsailBucket(ctx),
+			"aws_lightsail_database":                                       tableAwsLightsailDatabase(ctx),
 			"aws_lightsail_instance":                                       tableAwsLightsailInstance(ctx),
 			"aws_macie2_classification_job":                                tableAwsMacie2ClassificationJob(ctx),
 			"aws_media_store_container":                                    tableAwsMediaStoreContainer(ctx),
 			"aws_memorydb_cluster":                                         tableAwsMemoryDBCluster(ctx),
 			"aws_mgn_application":                                          tableAwsMGNApplication(ctx),
 			"aws_mq_broker":                                                tableAwsMQBroker(ctx),
+			"aws_mq_configuration":                                         tableAwsMQConfiguration(ctx),
 			"aws_msk_cluster":                                              tableAwsMSKCluster(ctx),
+			"aws_msk_cluster_operation":                                    tableAwsMSKClusterOperation(ctx),
+			"aws_msk_configuration":                                        tableAwsMSKConfiguration(ctx),
 			"aws_msk_serverless_cluster":                                   tableAwsMSKServerlessCluster(ctx),
 			"aws_neptune_db_cluster":                                       tableAwsNeptuneDBCluster(ctx),
 			"aws_neptune_db_cluster_snapshot":                              tableAwsNeptuneDBClusterSnapshot(ctx),
@@ -433,25 +466,30 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_redshift_cluster_metric_cpu_utilization_daily":            tableAwsRedshiftClusterMetricCpuUtilizationDaily(ctx),
 			"aws_redshift_event_subscription":                              tableAwsRedshiftEventSubscription(ctx),
 			"aws_redshift_parameter_group":                                 tableAwsRedshiftParameterGroup(ctx),
+			"aws_redshift_scheduled_action":                                tableAwsRedshiftScheduledAction(ctx),
 			"aws_redshift_snapshot":                                        tableAwsRedshiftSnapshot(ctx),
 			"aws_redshift_subnet_group":                                    tableAwsRedshiftSubnetGroup(ctx),
+			"aws_redshift_usage_limit":                                     tableAwsRedshiftUsageLimit(ctx),
 			"aws_redshiftserverless_namespace":                             tableAwsRedshiftServerlessNamespace(ctx),
 			"aws_redshiftserverless_workgroup":                             tableAwsRedshiftServerlessWorkgroup(ctx),
 			"aws_region":                                                   tableAwsRegion(ctx),
 			"aws_resource_explorer_index":                                  tableAWSResourceExplorerIndex(ctx),
 			"aws_resource_explorer_search":                                 tableAWSResourceExplorerSearch(ctx),
 			"aws_resource_explorer_supported_resource_type":                tableAWSResourceExplorerSupportedResourceType(ctx),
+			"aws_route53_cidr_collection":                                  tableAwsRoute53CidrCollection(ctx),
 			"aws_route53_domain":                                           tableAwsRoute53Domain(ctx),
 			"aws_route53_health_check":                                     tableAwsRoute53HealthCheck(ctx),
 			"aws_route53_query_log":                                        tableAwsRoute53QueryLog(ctx),
 			"aws_route53_record":                                           tableAwsRoute53Record(ctx),
 			"aws_route53_resolver_endpoint":                                tableAwsRoute53ResolverEndpoint(ctx),
+			"aws_route53_resolver_firewall_rule_group":                     tableAwsRoute53ResolverFirewallRuleGroup(ctx),
 			"aws_route53_resolver_query_log_config":                        tableAwsRoute53ResolverQueryLogConfig(ctx),
 			"aws_route53_resolver_rule":                                    tableAwsRoute53ResolverRule(ctx),
 			"aws_route53_traffic_policy":                                   tableAwsRoute53TrafficPolicy(ctx),
 			"aws_route53_traffic_policy_instance":                          tableAwsRoute53TrafficPolicyInstance(ctx),
 			"aws_route53_vpc_association_authorization":                    tableAwsRoute53VPCAssociationAuthorization(ctx),
 			"aws_route53_zone":                                             tableAwsRoute53Zone(ctx),
+			"aws_s3_access_grant":                                          tableAwsS3AccessGrant(ctx),
 			"aws_s3_access_point":                                          tableAwsS3AccessPoint(ctx),
 			"aws_s3_account_settings":                                      tableAwsS3AccountSettings(ctx),
 			"aws_s3_bucket":                                                tableAwsS3Bucket(ctx),
@@ -459,14 +497,19 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_s3_multi_region_access_point":                             tableAwsS3MultiRegionAccessPoint(ctx),
 			"aws_s3_object":                                                tableAwsS3Object(ctx),
 			"aws_s3_object_version":                                        tableAwsS3ObjectVersion(ctx),
+			"aws_s3_storage_lens_configuration":                            tableAwsS3StorageLensConfiguration(ctx),
 			"aws_sagemaker_app":                                            tableAwsSageMakerApp(ctx),
 			"aws_sagemaker_domain":                                         tableAwsSageMakerDomain(ctx),
+			"aws_sagemaker_endpoint":                                       tableAwsSageMakerEndpoint(ctx),
 			"aws_sagemaker_endpoint_configuration":                         tableAwsSageMakerEndpointConfiguration(ctx),
+			"aws_sagemaker_feature_group":                                  tableAwsSageMakerFeatureGroup(ctx),
 			"aws_sagemaker_model":                                          tableAwsSageMakerModel(ctx),
 			"aws_sagemaker_notebook_instance":                              tableAwsSageMakerNotebookInstance(ctx),
+			"aws_sagemaker_pipeline":                                       tableAwsSageMakerPipeline(ctx),
 			"aws_sagemaker_training_job":                                   tableAwsSageMakerTrainingJob(ctx),
 			"aws_scheduler_schedule":                                       tableAwsSchedulerSchedule(ctx),
 			"aws_secretsmanager_secret":                                    tableAwsSecretsManagerSecret(ctx),
+			"aws_secretsmanager_secret_version":                            tableAwsSecretsManagerSecretVersion(ctx),
 			"aws_securityhub_action_target":                                tableAwsSecurityHubActionTarget(ctx),
 			"aws_securityhub_finding":                                      tableAwsSecurityHubFinding(ctx),
 			"aws_securityhub_finding_aggregator":                           tableAwsSecurityHubFindingAggregator(ctx),
@@ -490,6 +533,8 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_servicequotas_service":                                    tableAwsServiceQuotasService(ctx),
 			"aws_servicequotas_service_quota":                              tableAwsServiceQuotasServiceQuota(ctx),
 			"aws_servicequotas_service_quota_change_request":               tableAwsServiceQuotasServiceQuotaChangeRequest(ctx),
+			"aws_ses_configuration_set":                                    tableAwsSESConfigurationSet(ctx),
+			"aws_ses_contact_list":                                         tableAwsSESContactList(ctx),
 			"aws_ses_domain_identity":                                      tableAwsSESDomainIdentity(ctx),
 			"aws_ses_email_identity":                                       tableAwsSESEmailIdentity(ctx),
 			"aws_sfn_state_machine":                                        tableAwsStepFunctionsStateMachine(ctx),
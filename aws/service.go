@@ -33,6 +33,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
@@ -81,6 +82,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/health"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
 	"github.com/aws/aws-sdk-go-v2/service/inspector"
 	"github.com/aws/aws-sdk-go-v2/service/inspector2"
 	"github.com/aws/aws-sdk-go-v2/service/iot"
@@ -127,6 +129,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/servicediscovery"
 	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
 	"github.com/aws/aws-sdk-go-v2/service/ses"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
 	"github.com/aws/aws-sdk-go-v2/service/shield"
 	"github.com/aws/aws-sdk-go-v2/service/simspaceweaver"
@@ -158,6 +161,7 @@ import (
 	appsyncv2Endpoint "github.com/aws/aws-sdk-go/service/appsync"
 	auditmanagerEndpoint "github.com/aws/aws-sdk-go/service/auditmanager"
 	backupEndpoint "github.com/aws/aws-sdk-go/service/backup"
+	batchEndpoint "github.com/aws/aws-sdk-go/service/batch"
 	cloudsearchEndpoint "github.com/aws/aws-sdk-go/service/cloudsearch"
 	codeartifactEndpoint "github.com/aws/aws-sdk-go/service/codeartifact"
 	codebuildEndpoint "github.com/aws/aws-sdk-go/service/codebuild"
@@ -176,6 +180,7 @@ import (
 	eventbridgeEndpoint "github.com/aws/aws-sdk-go/service/eventbridge"
 	fsxEndpoint "github.com/aws/aws-sdk-go/service/fsx"
 	glacierEndpoint "github.com/aws/aws-sdk-go/service/glacier"
+	imagebuilderEndpoint "github.com/aws/aws-sdk-go/service/imagebuilder"
 	inspectorEndpoint "github.com/aws/aws-sdk-go/service/inspector"
 	inspector2Endpoint "github.com/aws/aws-sdk-go/service/inspector2"
 	iotEndpoint "github.com/aws/aws-sdk-go/service/iot"
@@ -207,6 +212,7 @@ import (
 	serverlessrepoEndpoint "github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
 	servicequotasEndpoint "github.com/aws/aws-sdk-go/service/servicequotas"
 	sesEndpoint "github.com/aws/aws-sdk-go/service/ses"
+	sesv2Endpoint "github.com/aws/aws-sdk-go/service/sesv2"
 	simspaceWeaverEndpoint "github.com/aws/aws-sdk-go/service/simspaceweaver"
 	ssmEndpoint "github.com/aws/aws-sdk-go/service/ssm"
 	ssmIncidentsEndpoint "github.com/aws/aws-sdk-go/service/ssmincidents"
@@ -389,6 +395,17 @@ func BackupClient(ctx context.Context, d *plugin.QueryData) (*backup.Client, err
 	return backup.NewFromConfig(*cfg), nil
 }
 
+func BatchClient(ctx context.Context, d *plugin.QueryData) (*batch.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, batchEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return batch.NewFromConfig(*cfg), nil
+}
+
 func CloudControlClient(ctx context.Context, d *plugin.QueryData) (*cloudcontrol.Client, error) {
 	// CloudControl returns GeneralServiceException in a lot of situations, which
 	// AWS SDK treats as retryable. This is frustrating because we end up retrying
@@ -472,6 +489,18 @@ func CloudWatchClient(ctx context.Context, d *plugin.QueryData) (*cloudwatch.Cli
 	return cloudwatch.NewFromConfig(*cfg), nil
 }
 
+func CloudWatchDashboardClient(ctx context.Context, d *plugin.QueryData) (*cloudwatch.Client, error) {
+	// CloudWatch dashboards are global resources, the same dashboards are
+	// returned by every regional endpoint.
+	// https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/CloudWatch_Dashboards.html
+	// So, use the default region to avoid listing each dashboard once per region.
+	cfg, err := getClientForDefaultRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	return cloudwatch.NewFromConfig(*cfg), nil
+}
+
 func CloudWatchLogsClient(ctx context.Context, d *plugin.QueryData) (*cloudwatchlogs.Client, error) {
 	cfg, err := getClientForQueryRegion(ctx, d)
 	if err != nil {
@@ -888,6 +917,17 @@ func IdentityStoreClient(ctx context.Context, d *plugin.QueryData) (*identitysto
 	return identitystore.NewFromConfig(*cfg), nil
 }
 
+func ImageBuilderClient(ctx context.Context, d *plugin.QueryData) (*imagebuilder.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, imagebuilderEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return imagebuilder.NewFromConfig(*cfg), nil
+}
+
 func InspectorClient(ctx context.Context, d *plugin.QueryData) (*inspector.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, inspectorEndpoint.EndpointsID)
 	if err != nil {
@@ -1471,6 +1511,17 @@ func SESClient(ctx context.Context, d *plugin.QueryData) (*ses.Client, error) {
 	return ses.NewFromConfig(*cfg), nil
 }
 
+func SESV2Client(ctx context.Context, d *plugin.QueryData) (*sesv2.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, sesv2Endpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return sesv2.NewFromConfig(*cfg), nil
+}
+
 func ServerlessApplicationRepositoryClient(ctx context.Context, d *plugin.QueryData) (*serverlessapplicationrepository.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, serverlessrepoEndpoint.EndpointsID)
 	if err != nil {
@@ -2,11 +2,13 @@ package aws
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/acmpca"
 	"github.com/aws/aws-sdk-go-v2/service/acmpca/types"
+	"github.com/aws/smithy-go"
 
 	acmpcav1 "github.com/aws/aws-sdk-go/service/acmpca"
 
@@ -38,6 +40,10 @@ func tableAwsAcmPcaCertificateAuthority(_ context.Context) *plugin.Table {
 				Func: getAwsAcmPcaCertificateAuthority,
 				Tags: map[string]string{"service": "acm-pca", "action": "DescribeCertificateAuthority"},
 			},
+			{
+				Func: getAcmPcaCertificateAuthorityCsr,
+				Tags: map[string]string{"service": "acm-pca", "action": "GetCertificateAuthorityCsr"},
+			},
 			{
 				Func: listTagsForAcmPcaAuthority,
 				Tags: map[string]string{"service": "acm-pca", "action": "ListTags"},
@@ -110,6 +116,25 @@ func tableAwsAcmPcaCertificateAuthority(_ context.Context) *plugin.Table {
 				Description: "Specifies whether the CA issues general-purpose certificates that typically require a revocation mechanism, or short-lived certificates that may optionally omit revocation because they expire quickly. Short-lived certificate validity is limited to seven days. The default value is GENERAL_PURPOSE.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "key_algorithm",
+				Description: "Type of the public key algorithm and size, in bits, of the key pair that your CA creates when it issues a certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateAuthorityConfiguration.KeyAlgorithm"),
+			},
+			{
+				Name:        "signing_algorithm",
+				Description: "Name of the algorithm your private CA uses to sign certificate requests.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateAuthorityConfiguration.SigningAlgorithm"),
+			},
+			{
+				Name:        "csr",
+				Description: "The base64 PEM-encoded certificate signing request (CSR) for your private CA certificate.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAcmPcaCertificateAuthorityCsr,
+				Transform:   transform.FromField("Csr"),
+			},
 			{
 				Name:        "certificate_authority_configuration",
 				Description: "Your private CA configuration.",
@@ -240,6 +265,45 @@ func getAwsAcmPcaCertificateAuthority(ctx context.Context, d *plugin.QueryData,
 	return detail.CertificateAuthority, nil
 }
 
+func getAcmPcaCertificateAuthorityCsr(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn, err := getAcmPcaAuthorityArn(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_acmpca_certificate_authority.getAcmPcaCertificateAuthorityCsr", "type_error", err)
+		return nil, err
+	}
+
+	if arn == nil {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := ACMPCAClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_acmpca_certificate_authority.getAcmPcaCertificateAuthorityCsr", "connection_error", err)
+		return nil, err
+	}
+
+	// Build param
+	param := &acmpca.GetCertificateAuthorityCsrInput{
+		CertificateAuthorityArn: arn,
+	}
+
+	csr, err := svc.GetCertificateAuthorityCsr(ctx, param)
+	if err != nil {
+		// The CSR is not available while the CA is still being created, or
+		// once it has failed or been deleted
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			if ae.ErrorCode() == "InvalidStateException" || ae.ErrorCode() == "RequestInProgressException" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("aws_acmpca_certificate_authority.getAcmPcaCertificateAuthorityCsr", "api_error", err)
+		return nil, err
+	}
+	return csr, nil
+}
+
 func listTagsForAcmPcaAuthority(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	arn, err := getAcmPcaAuthorityArn(ctx, d, h)
 
@@ -0,0 +1,298 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/aws/aws-sdk-go-v2/service/amplify/types"
+
+	amplifyv1 "github.com/aws/aws-sdk-go/service/amplify"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsAmplifyBranch(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_amplify_branch",
+		Description: "AWS Amplify Branch",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"app_id", "branch_name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ValidationException", "NotFoundException"}),
+			},
+			Hydrate: getAmplifyBranch,
+			Tags:    map[string]string{"service": "amplify", "action": "GetBranch"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAmplifyApps,
+			Hydrate:       listAmplifyBranches,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "app_id", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "amplify", "action": "ListBranches"},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(amplifyv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "branch_name",
+				Description: "The name of the branch.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "app_id",
+				Description: "The unique ID of the Amplify app the branch belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "branch_arn",
+				Description: "The Amazon Resource Name (ARN) for the branch.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stage",
+				Description: "The current stage for the branch that is part of an Amplify app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name for the branch.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description for the branch that is part of an Amplify app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation date and time for a branch that is part of an Amplify app.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "update_time",
+				Description: "The last updated date and time for a branch that is part of an Amplify app.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "active_job_id",
+				Description: "The ID of the active job for a branch of an Amplify app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "total_number_of_jobs",
+				Description: "The total number of jobs that are part of an Amplify app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enable_auto_build",
+				Description: "Enables auto-building on push for a branch of an Amplify app.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "enable_basic_auth",
+				Description: "Enables basic authorization for a branch of an Amplify app.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "enable_notification",
+				Description: "Enables notifications for a branch that is part of an Amplify app.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "enable_performance_mode",
+				Description: "Enables performance mode for the branch.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "enable_pull_request_preview",
+				Description: "Enables pull request previews for the branch.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "framework",
+				Description: "The framework for a branch of an Amplify app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ttl",
+				Description: "The content Time to Live (TTL) for the website in seconds.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "backend_environment_arn",
+				Description: "The Amazon Resource Name (ARN) for a backend environment that is part of an Amplify app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_branch",
+				Description: "The source branch if the branch is a pull request branch.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "destination_branch",
+				Description: "The destination branch if the branch is a pull request branch.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "thumbnail_url",
+				Description: "The thumbnail URL for the branch of an Amplify app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "associated_resources",
+				Description: "A list of custom resources that are linked to this branch.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "backend",
+				Description: "Describes the backend properties associated with an Amplify branch.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "build_spec",
+				Description: "The build specification (build spec) content for the branch of an Amplify app.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("BuildSpec").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "custom_domains",
+				Description: "The custom domains for a branch of an Amplify app.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "environment_variables",
+				Description: "The environment variables specific to a branch of an Amplify app.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BranchName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("BranchArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+type amplifyBranchInfo struct {
+	types.Branch
+	AppId *string
+}
+
+//// LIST FUNCTION
+
+func listAmplifyBranches(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	app := h.Item.(types.App)
+
+	// Limit API call with given App ID
+	if d.EqualsQualString("app_id") != "" && d.EqualsQualString("app_id") != *app.AppId {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AmplifyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_amplify_branch.listAmplifyBranches", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	maxItems := int32(50)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			maxItems = limit
+		}
+	}
+
+	input := &amplify.ListBranchesInput{
+		AppId:      app.AppId,
+		MaxResults: maxItems,
+	}
+
+	paginator := amplify.NewListBranchesPaginator(svc, input, func(o *amplify.ListBranchesPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_amplify_branch.listAmplifyBranches", "api_error", err)
+			return nil, err
+		}
+
+		for _, branch := range output.Branches {
+			d.StreamListItem(ctx, amplifyBranchInfo{branch, app.AppId})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAmplifyBranch(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	appId := d.EqualsQualString("app_id")
+	branchName := d.EqualsQualString("branch_name")
+
+	// Empty id check
+	if appId == "" || branchName == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AmplifyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_amplify_branch.getAmplifyBranch", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &amplify.GetBranchInput{
+		AppId:      aws.String(appId),
+		BranchName: aws.String(branchName),
+	}
+
+	data, err := svc.GetBranch(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_amplify_branch.getAmplifyBranch", "api_error", err)
+		return nil, err
+	}
+
+	return amplifyBranchInfo{*data.Branch, aws.String(appId)}, nil
+}
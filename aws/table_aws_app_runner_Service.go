@@ -51,6 +51,11 @@ func tableAwsAppRunnerService(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("ServiceArn"),
 			},
+			{
+				Name:        "status",
+				Description: "The current state of the App Runner service.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "created_at",
 				Description: "The time when the App Runner service was created. It's in the Unix time stamp format.",
@@ -121,6 +126,13 @@ func tableAwsAppRunnerService(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Hydrate:     getAwsAppRunnerService,
 			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the App Runner service.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsAppRunnerServiceTags,
+				Transform:   transform.FromValue(),
+			},
 
 			// Standard standard columns
 			{
@@ -129,6 +141,13 @@ func tableAwsAppRunnerService(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("ServiceName"),
 			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsAppRunnerServiceTags,
+				Transform:   transform.From(appRunnerTurbotTags),
+			},
 			{
 				Name:        "akas",
 				Description: resourceInterfaceDescription("akas"),
@@ -231,3 +250,52 @@ func getAwsAppRunnerService(ctx context.Context, d *plugin.QueryData, h *plugin.
 
 	return service.Service, nil
 }
+
+func getAwsAppRunnerServiceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn string
+	switch item := h.Item.(type) {
+	case types.ServiceSummary:
+		arn = *item.ServiceArn
+	case *types.Service:
+		arn = *item.ServiceArn
+	}
+
+	// Create session
+	svc, err := AppRunnerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_app_runner_service.getAwsAppRunnerServiceTags", "client_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		return nil, nil // Unsupported region check
+	}
+
+	params := &apprunner.ListTagsForResourceInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_app_runner_service.getAwsAppRunnerServiceTags", "api_error", err)
+		return nil, err
+	}
+
+	return op.Tags, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func appRunnerTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.HydrateItem.([]types.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return turbotTagsMap, nil
+}
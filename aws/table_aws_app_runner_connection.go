@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+
+	apprunnerv1 "github.com/aws/aws-sdk-go/service/apprunner"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsAppRunnerConnection(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_app_runner_connection",
+		Description: "AWS App Runner Connection",
+		List: &plugin.ListConfig{
+			Hydrate: listAwsAppRunnerConnections,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "connection_name", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "apprunner", "action": "ListConnections"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getAwsAppRunnerConnectionTags,
+				Tags: map[string]string{"service": "apprunner", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(apprunnerv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "connection_name",
+				Description: "The customer-provided connection name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of this connection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectionArn"),
+			},
+			{
+				Name:        "provider_type",
+				Description: "The source repository provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current state of the App Runner connection. When the state is AVAILABLE, you can use the connection to create an App Runner service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The App Runner connection creation time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the App Runner connection.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsAppRunnerConnectionTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectionName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsAppRunnerConnectionTags,
+				Transform:   transform.From(appRunnerTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConnectionArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsAppRunnerConnections(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := AppRunnerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_app_runner_connection.listAwsAppRunnerConnections", "client_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		return nil, nil // Unsupported region check
+	}
+
+	// Limit the result
+	input := &apprunner.ListConnectionsInput{
+		MaxResults: aws.Int32(100),
+	}
+
+	if d.EqualsQualString("connection_name") != "" {
+		input.ConnectionName = aws.String(d.EqualsQualString("connection_name"))
+	}
+
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < *input.MaxResults {
+			if limit < 1 {
+				input.MaxResults = aws.Int32(1)
+			} else {
+				input.MaxResults = aws.Int32(limit)
+			}
+		}
+	}
+
+	paginator := apprunner.NewListConnectionsPaginator(svc, input, func(o *apprunner.ListConnectionsPaginatorOptions) {
+		o.Limit = *input.MaxResults
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_app_runner_connection.listAwsAppRunnerConnections", "api_error", err)
+			return nil, err
+		}
+
+		for _, connection := range output.ConnectionSummaryList {
+			d.StreamListItem(ctx, connection)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsAppRunnerConnectionTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn := *h.Item.(types.ConnectionSummary).ConnectionArn
+
+	// Create session
+	svc, err := AppRunnerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_app_runner_connection.getAwsAppRunnerConnectionTags", "client_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		return nil, nil // Unsupported region check
+	}
+
+	params := &apprunner.ListTagsForResourceInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_app_runner_connection.getAwsAppRunnerConnectionTags", "api_error", err)
+		return nil, err
+	}
+
+	return op.Tags, nil
+}
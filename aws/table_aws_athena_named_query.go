@@ -0,0 +1,212 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	"github.com/aws/aws-sdk-go-v2/service/athena/types"
+
+	athenav1 "github.com/aws/aws-sdk-go/service/athena"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsAthenaNamedQuery(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_athena_named_query",
+		Description: "AWS Athena Named Query",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("named_query_id"),
+			Hydrate:    getAwsAthenaNamedQuery,
+			Tags:       map[string]string{"service": "athena", "action": "GetNamedQuery"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidRequestException"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAwsAthenaWorkGroups,
+			Hydrate:       listAwsAthenaNamedQueries,
+			Tags:          map[string]string{"service": "athena", "action": "ListNamedQueries"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "work_group_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(athenav1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "named_query_id",
+				Description: "The unique identifier of the query.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The query name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The query description.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "work_group_name",
+				Description: "The name of the workgroup that contains the named query.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WorkGroup"),
+			},
+			{
+				Name:        "database",
+				Description: "The database to which the query belongs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "query_string",
+				Description: "The SQL statements that make up the query.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsAthenaNamedQueries(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	svc, err := AthenaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_athena_named_query.listAwsAthenaNamedQueries", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	workgroup := h.Item.(types.WorkGroup)
+	if d.EqualsQualString("work_group_name") != "" {
+		if d.EqualsQualString("work_group_name") != *workgroup.Name {
+			return nil, nil
+		}
+	}
+
+	// BatchGetNamedQuery accepts up to 50 IDs, which is also the page size limit of ListNamedQueries
+	maxResults := int32(50)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxResults {
+			maxResults = int32(limit)
+		}
+	}
+
+	input := athena.ListNamedQueriesInput{
+		MaxResults: aws.Int32(maxResults),
+		WorkGroup:  workgroup.Name,
+	}
+
+	paginator := athena.NewListNamedQueriesPaginator(svc, &input, func(o *athena.ListNamedQueriesPaginatorOptions) {
+		o.Limit = maxResults
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_athena_named_query.listAwsAthenaNamedQueries", "api_error", err)
+			return nil, err
+		}
+
+		if len(output.NamedQueryIds) == 0 {
+			continue
+		}
+
+		// Fetch the details of the whole page in a single call
+		batchOutput, err := svc.BatchGetNamedQuery(ctx, &athena.BatchGetNamedQueryInput{
+			NamedQueryIds: output.NamedQueryIds,
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_athena_named_query.listAwsAthenaNamedQueries", "batch_api_error", err)
+			return nil, err
+		}
+
+		namedQueries := batchOutput.NamedQueries
+
+		// Retry the named queries that could not be fetched in the batch one at a time
+		for _, unprocessed := range batchOutput.UnprocessedNamedQueryIds {
+			rowData, err := svc.GetNamedQuery(ctx, &athena.GetNamedQueryInput{
+				NamedQueryId: unprocessed.NamedQueryId,
+			})
+			if err != nil {
+				plugin.Logger(ctx).Warn("aws_athena_named_query.listAwsAthenaNamedQueries", "unprocessed_named_query_id", aws.ToString(unprocessed.NamedQueryId), "error_code", aws.ToString(unprocessed.ErrorCode), "api_error", err)
+				continue
+			}
+			if rowData.NamedQuery != nil {
+				namedQueries = append(namedQueries, *rowData.NamedQuery)
+			}
+		}
+
+		for _, namedQuery := range namedQueries {
+			d.StreamListItem(ctx, namedQuery)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsAthenaNamedQuery(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("named_query_id")
+
+	// Empty input check
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AthenaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_athena_named_query.getAwsAthenaNamedQuery", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build params
+	params := &athena.GetNamedQueryInput{
+		NamedQueryId: aws.String(id),
+	}
+
+	rowData, err := svc.GetNamedQuery(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_athena_named_query.getAwsAthenaNamedQuery", "api_error", err)
+		return nil, err
+	}
+
+	return *rowData.NamedQuery, nil
+}
@@ -0,0 +1,197 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	"github.com/aws/aws-sdk-go-v2/service/athena/types"
+
+	athenav1 "github.com/aws/aws-sdk-go/service/athena"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsAthenaPreparedStatement(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_athena_prepared_statement",
+		Description: "AWS Athena Prepared Statement",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"statement_name", "work_group_name"}),
+			Hydrate:    getAwsAthenaPreparedStatement,
+			Tags:       map[string]string{"service": "athena", "action": "GetPreparedStatement"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "InvalidRequestException"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAwsAthenaWorkGroups,
+			Hydrate:       listAwsAthenaPreparedStatements,
+			Tags:          map[string]string{"service": "athena", "action": "ListPreparedStatements"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "work_group_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getAwsAthenaPreparedStatement,
+				Tags: map[string]string{"service": "athena", "action": "GetPreparedStatement"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(athenav1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "statement_name",
+				Description: "The name of the prepared statement.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "work_group_name",
+				Description: "The name of the workgroup to which the prepared statement belongs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The last modified time of the prepared statement.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the prepared statement.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsAthenaPreparedStatement,
+			},
+			{
+				Name:        "query_statement",
+				Description: "The query string for the prepared statement.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsAthenaPreparedStatement,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StatementName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsAthenaPreparedStatements(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	svc, err := AthenaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_athena_prepared_statement.listAwsAthenaPreparedStatements", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	workgroup := h.Item.(types.WorkGroup)
+	if d.EqualsQualString("work_group_name") != "" {
+		if d.EqualsQualString("work_group_name") != *workgroup.Name {
+			return nil, nil
+		}
+	}
+
+	maxResults := int32(50)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxResults {
+			maxResults = int32(limit)
+		}
+	}
+
+	input := athena.ListPreparedStatementsInput{
+		MaxResults: aws.Int32(maxResults),
+		WorkGroup:  workgroup.Name,
+	}
+
+	paginator := athena.NewListPreparedStatementsPaginator(svc, &input, func(o *athena.ListPreparedStatementsPaginatorOptions) {
+		o.Limit = maxResults
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_athena_prepared_statement.listAwsAthenaPreparedStatements", "api_error", err)
+			return nil, err
+		}
+
+		for _, statement := range output.PreparedStatements {
+			d.StreamListItem(ctx, types.PreparedStatement{
+				StatementName:    statement.StatementName,
+				LastModifiedTime: statement.LastModifiedTime,
+				WorkGroupName:    workgroup.Name,
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsAthenaPreparedStatement(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name, workgroup string
+	if h.Item != nil {
+		statement := h.Item.(types.PreparedStatement)
+		name = *statement.StatementName
+		workgroup = *statement.WorkGroupName
+	} else {
+		name = d.EqualsQualString("statement_name")
+		workgroup = d.EqualsQualString("work_group_name")
+	}
+
+	// Empty input check
+	if name == "" || workgroup == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AthenaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_athena_prepared_statement.getAwsAthenaPreparedStatement", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build params
+	params := &athena.GetPreparedStatementInput{
+		StatementName: aws.String(name),
+		WorkGroup:     aws.String(workgroup),
+	}
+
+	rowData, err := svc.GetPreparedStatement(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_athena_prepared_statement.getAwsAthenaPreparedStatement", "api_error", err)
+		return nil, err
+	}
+
+	return *rowData.PreparedStatement, nil
+}
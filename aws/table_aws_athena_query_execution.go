@@ -2,7 +2,6 @@ package aws
 
 import (
 	"context"
-	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/athena"
@@ -27,7 +26,7 @@ func tableAwsAthenaQueryExecution(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			ParentHydrate: listAwsAthenaWorkGroups,
 			Hydrate:       listAwsAthenaQueryExecutions,
-			Tags:          map[string]string{"service": "athena", "action": "GetQueryExecutions"},
+			Tags:          map[string]string{"service": "athena", "action": "ListQueryExecutions"},
 			KeyColumns: plugin.KeyColumnSlice{
 				{
 					Name:    "workgroup",
@@ -35,12 +34,6 @@ func tableAwsAthenaQueryExecution(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		HydrateConfig: []plugin.HydrateConfig{
-			{
-				Func: getAwsAthenaQueryExecution,
-				Tags: map[string]string{"service": "athena", "action": "GetQueryExecution"},
-			},
-		},
 		GetMatrixItemFunc: SupportedRegionMatrix(athenav1.EndpointsID),
 		Columns: awsRegionalColumns([]*plugin.Column{
 			{
@@ -53,226 +46,218 @@ func tableAwsAthenaQueryExecution(_ context.Context) *plugin.Table {
 				Name:        "workgroup",
 				Description: "The name of the workgroup in which the query ran.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.WorkGroup"),
 			},
 			{
 				Name:        "catalog",
 				Description: "The name of the data catalog used in the query execution.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.QueryExecutionContext.Catalog"),
 			},
 			{
 				Name:        "database",
 				Description: "The name of the data database used in the query execution.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.QueryExecutionContext.Database"),
 			},
 			{
 				Name:        "query",
 				Description: "The SQL query statements which the query execution ran.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.Query"),
 			},
 			{
 				Name:        "effective_engine_version",
 				Description: "The engine version on which the query runs.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.EngineVersion.EffectiveEngineVersion"),
 			},
 			{
 				Name:        "selected_engine_version",
 				Description: "The engine version requested by the users.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.EngineVersion.SelectedEngineVersion"),
 			},
 			{
 				Name:        "execution_parameters",
 				Description: "A list of values for the parameters in a query.",
 				Type:        proto.ColumnType_JSON,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.ExecutionParameters"),
 			},
 			{
 				Name:        "statement_type",
 				Description: "The type of query statement that was run.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.StatementType"),
 			},
 			{
 				Name:        "substatement_type",
 				Description: "The kind of query statement that was run.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.SubstatementType"),
 			},
 			{
 				Name:        "state",
 				Description: "The state of query execution.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.Status.State"),
 			},
 			{
 				Name:        "state_change_reason",
 				Description: "Further detail about the status of the query.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.Status.StateChangeReason"),
 			},
 			{
 				Name:        "submission_date_time",
 				Description: "The date and time that the query was submitted.",
 				Type:        proto.ColumnType_TIMESTAMP,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.Status.SubmissionDateTime"),
 			},
 			{
 				Name:        "completion_date_time",
 				Description: "The date and time that the query completed.",
 				Type:        proto.ColumnType_TIMESTAMP,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.Status.CompletionDateTime"),
 			},
 			{
 				Name:        "error_message",
 				Description: "Contains a short description of the error that occurred.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.Status.AthenaError.ErrorMessage"),
 			},
 			{
 				Name:        "error_type",
 				Description: "An integer value that provides specific information about an Athena query error.",
 				Type:        proto.ColumnType_INT,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.Status.AthenaError.ErrorType"),
 			},
 			{
 				Name:        "error_category",
 				Description: "An integer value that specifies the category of a query failure error.",
 				Type:        proto.ColumnType_INT,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.Status.AthenaError.ErrorCategory"),
 			},
 			{
 				Name:        "retryable",
 				Description: "True if the query might succeed if resubmitted.",
 				Type:        proto.ColumnType_BOOL,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.Status.AthenaError.Retryable"),
 			},
 			{
 				Name:        "data_manifest_location",
 				Description: "The location and file name of a data manifest file.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.Statistics.DataManifestLocation"),
 			},
 			{
 				Name:        "data_scanned_in_bytes",
 				Description: "The number of bytes in the data that was queried.",
 				Type:        proto.ColumnType_INT,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.Statistics.DataScannedInBytes"),
 			},
 			{
 				Name:        "engine_execution_time_in_millis",
 				Description: "The number of milliseconds that the query took to execute.",
 				Type:        proto.ColumnType_INT,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.Statistics.EngineExecutionTimeInMillis"),
 			},
 			{
 				Name:        "query_planning_time_in_millis",
 				Description: "The number of milliseconds that Athena took to plan the query processing flow.",
 				Type:        proto.ColumnType_INT,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.Statistics.QueryPlanningTimeInMillis"),
 			},
 			{
 				Name:        "query_queue_time_in_millis",
 				Description: "The number of milliseconds that the query was in your query queue waiting for resources.",
 				Type:        proto.ColumnType_INT,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.Statistics.QueryQueueTimeInMillis"),
 			},
 			{
 				Name:        "service_processing_time_in_millis",
 				Description: "The number of milliseconds that Athena took to finalize and publish the query results after the query engine finished running the query.",
 				Type:        proto.ColumnType_INT,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.Statistics.ServiceProcessingTimeInMillis"),
 			},
 			{
 				Name:        "total_execution_time_in_millis",
 				Description: "The number of milliseconds that Athena took to run the query.",
 				Type:        proto.ColumnType_INT,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.Statistics.TotalExecutionTimeInMillis"),
 			},
 			{
 				Name:        "reused_previous_result",
 				Description: "True if a previous query result was reused; false if the result was generated.",
 				Type:        proto.ColumnType_BOOL,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.Statistics.ResultReuseInformation.ReusedPreviousResult"),
 			},
 			{
 				Name:        "s3_acl_option",
 				Description: "The Amazon S3 canned ACL that Athena should specify when storing query results.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.ResultConfiguration.AclConfiguration.S3AclOption"),
 			},
 			{
 				Name:        "encryption_option",
 				Description: "Indicates whether Amazon S3 server-side encryption with Amazon S3-managed keys (SSE_S3), server-side encryption with KMS-managed keys (SSE_KMS), or client-side encryption with KMS-managed keys (CSE_KMS) is used.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.ResultConfiguration.EncryptionConfiguration.EncryptionOption"),
 			},
 			{
 				Name:        "kms_key",
 				Description: "For SSE_KMS and CSE_KMS, this is the KMS key ARN or ID.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.ResultConfiguration.EncryptionConfiguration.KmsKey"),
 			},
 			{
 				Name:        "expected_bucket_owner",
 				Description: "The Amazon Web Services account ID that you expect to be the owner of the Amazon S3 bucket specified by ResultConfiguration$OutputLocation.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.ResultConfiguration.ExpectedBucketOwner"),
 			},
 			{
 				Name:        "output_location",
 				Description: "The location in Amazon S3 where your query results are stored.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.ResultConfiguration.OutputLocation"),
 			},
 			{
 				Name:        "result_reuse_by_age_enabled",
 				Description: "True if previous query results can be reused when the query is run.",
 				Type:        proto.ColumnType_BOOL,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.ResultReuseConfiguration.ResultReuseByAgeConfiguration.Enabled"),
 			},
 			{
 				Name:        "result_reuse_by_age_mag_age_in_minutes",
 				Description: "Specifies, in minutes, the maximum age of a previous query result that Athena should consider for reuse. The default is 60.",
 				Type:        proto.ColumnType_INT,
-				Hydrate:     getAwsAthenaQueryExecution,
 				Transform:   transform.FromField("QueryExecution.ResultReuseConfiguration.ResultReuseByAgeConfiguration.MaxAgeInMinutes"),
 			},
+			{
+				Name:        "query_execution_context",
+				Description: "The database and data catalog context in which the query execution occurred.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("QueryExecution.QueryExecutionContext"),
+			},
+			{
+				Name:        "result_configuration",
+				Description: "The location in Amazon S3 where query and calculation results are stored and the encryption option, if any, used for query results.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("QueryExecution.ResultConfiguration"),
+			},
+			{
+				Name:        "status",
+				Description: "The completion date, current state, submission time, and state change reason (if applicable) for the query execution.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("QueryExecution.Status"),
+			},
+			{
+				Name:        "statistics",
+				Description: "Query execution statistics, such as the amount of data scanned, the amount of time that the query took to process, and the type of statement that was run.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("QueryExecution.Statistics"),
+			},
 		}),
 	}
 }
@@ -328,10 +313,37 @@ func listAwsAthenaQueryExecutions(ctx context.Context, d *plugin.QueryData, h *p
 			return nil, err
 		}
 
-		for _, queryExecutionId := range output.QueryExecutionIds {
-			id := strings.Clone(queryExecutionId)
-			var queryExecution = types.QueryExecution{QueryExecutionId: &id}
+		if len(output.QueryExecutionIds) == 0 {
+			continue
+		}
+
+		// Fetch the details of the whole page in a single call, BatchGetQueryExecution accepts up to 50 IDs
+		batchOutput, err := svc.BatchGetQueryExecution(ctx, &athena.BatchGetQueryExecutionInput{
+			QueryExecutionIds: output.QueryExecutionIds,
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_athena_query_execution.listAwsAthenaQueryExecutions", "batch_api_error", err)
+			return nil, err
+		}
+
+		queryExecutions := batchOutput.QueryExecutions
+
+		// Retry the query executions that could not be fetched in the batch one at a time
+		for _, unprocessed := range batchOutput.UnprocessedQueryExecutionIds {
+			rowData, err := svc.GetQueryExecution(ctx, &athena.GetQueryExecutionInput{
+				QueryExecutionId: unprocessed.QueryExecutionId,
+			})
+			if err != nil {
+				plugin.Logger(ctx).Warn("aws_athena_query_execution.listAwsAthenaQueryExecutions", "unprocessed_query_execution_id", aws.ToString(unprocessed.QueryExecutionId), "error_code", aws.ToString(unprocessed.ErrorCode), "api_error", err)
+				continue
+			}
+			if rowData.QueryExecution != nil {
+				queryExecutions = append(queryExecutions, *rowData.QueryExecution)
+			}
+		}
 
+		for _, item := range queryExecutions {
+			queryExecution := item
 			d.StreamListItem(ctx, athena.GetQueryExecutionOutput{QueryExecution: &queryExecution})
 
 			// Context may get cancelled due to manual cancellation or if the limit has been reached
@@ -50,6 +50,10 @@ func tableAwsBackupVault(_ context.Context) *plugin.Table {
 				Func: getAwsBackupVaultTags,
 				Tags: map[string]string{"service": "backup", "action": "ListTags"},
 			},
+			{
+				Func: getAwsBackupVaultProtectedResourceTypes,
+				Tags: map[string]string{"service": "backup", "action": "ListProtectedResourcesByBackupVault"},
+			},
 		},
 		GetMatrixItemFunc: SupportedRegionMatrix(backupv1.EndpointsID),
 		Columns: awsRegionalColumns([]*plugin.Column{
@@ -105,6 +109,13 @@ func tableAwsBackupVault(_ context.Context) *plugin.Table {
 				Description: "The number of recovery points that are stored in a backup vault.",
 				Type:        proto.ColumnType_DOUBLE,
 			},
+			{
+				Name:        "protected_resource_types",
+				Description: "The distinct types of the resources that have recovery points stored in the backup vault.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsBackupVaultProtectedResourceTypes,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "sns_topic_arn",
 				Description: "An ARN that uniquely identifies an Amazon Simple Notification Service.",
@@ -327,6 +338,53 @@ func getAwsBackupVaultAccessPolicy(ctx context.Context, d *plugin.QueryData, h *
 	return op, nil
 }
 
+func getAwsBackupVaultProtectedResourceTypes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := BackupClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_backup_vault.getAwsBackupVaultProtectedResourceTypes", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	name := vaultID(h.Item)
+	params := &backup.ListProtectedResourcesByBackupVaultInput{
+		BackupVaultName: aws.String(name),
+		MaxResults:      aws.Int32(1000),
+	}
+
+	paginator := backup.NewListProtectedResourcesByBackupVaultPaginator(svc, params, func(o *backup.ListProtectedResourcesByBackupVaultPaginatorOptions) {
+		o.Limit = 1000
+		o.StopOnDuplicateToken = true
+	})
+
+	resourceTypes := []string{}
+	seen := map[string]bool{}
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_backup_vault.getAwsBackupVaultProtectedResourceTypes", "api_error", err)
+			return nil, err
+		}
+
+		for _, resource := range output.Results {
+			if resource.ResourceType == nil || seen[*resource.ResourceType] {
+				continue
+			}
+			seen[*resource.ResourceType] = true
+			resourceTypes = append(resourceTypes, *resource.ResourceType)
+		}
+	}
+
+	return resourceTypes, nil
+}
+
 func vaultID(item interface{}) string {
 	switch item := item.(type) {
 	case types.BackupVaultListMember:
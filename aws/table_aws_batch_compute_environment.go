@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+
+	batchv1 "github.com/aws/aws-sdk-go/service/batch"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBatchComputeEnvironment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_batch_compute_environment",
+		Description: "AWS Batch Compute Environment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("compute_environment_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ClientException"}),
+			},
+			Hydrate: getBatchComputeEnvironment,
+			Tags:    map[string]string{"service": "batch", "action": "DescribeComputeEnvironments"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listBatchComputeEnvironments,
+			Tags:    map[string]string{"service": "batch", "action": "DescribeComputeEnvironments"},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(batchv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "compute_environment_name",
+				Description: "The name of the compute environment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compute_environment_arn",
+				Description: "The Amazon Resource Name (ARN) of the compute environment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the compute environment. Can be MANAGED or UNMANAGED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the compute environment. Can be ENABLED or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the compute environment, for example CREATING, UPDATING or VALID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_reason",
+				Description: "A short, human-readable string to provide additional details for the current status of the compute environment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_role",
+				Description: "The service role that is used to make calls to other Amazon Web Services services on your behalf.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ecs_cluster_arn",
+				Description: "The Amazon Resource Name (ARN) of the underlying Amazon ECS cluster that the compute environment uses.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "container_orchestration_type",
+				Description: "The orchestration type of the compute environment. Can be ECS or EKS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "unmanaged_vcpus",
+				Description: "The maximum number of vCPUs expected to be used for an unmanaged compute environment.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("UnmanagedvCpus"),
+			},
+			{
+				Name:        "uuid",
+				Description: "Unique identifier for the compute environment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compute_resources",
+				Description: "The compute resources defined for the compute environment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "eks_configuration",
+				Description: "The configuration for the Amazon EKS cluster that supports the compute environment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "update_policy",
+				Description: "Specifies the infrastructure update policy for the compute environment.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ComputeEnvironmentName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ComputeEnvironmentArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listBatchComputeEnvironments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := BatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_compute_environment.listBatchComputeEnvironments", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &batch.DescribeComputeEnvironmentsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := batch.NewDescribeComputeEnvironmentsPaginator(svc, input, func(o *batch.DescribeComputeEnvironmentsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_batch_compute_environment.listBatchComputeEnvironments", "api_error", err)
+			return nil, err
+		}
+
+		for _, environment := range output.ComputeEnvironments {
+			d.StreamListItem(ctx, environment)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBatchComputeEnvironment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("compute_environment_name")
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := BatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_compute_environment.getBatchComputeEnvironment", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &batch.DescribeComputeEnvironmentsInput{
+		ComputeEnvironments: []string{name},
+	}
+
+	op, err := svc.DescribeComputeEnvironments(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_compute_environment.getBatchComputeEnvironment", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.ComputeEnvironments) > 0 {
+		return op.ComputeEnvironments[0], nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,246 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+
+	batchv1 "github.com/aws/aws-sdk-go/service/batch"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBatchJobDefinition(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_batch_job_definition",
+		Description: "AWS Batch Job Definition",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("job_definition_arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ClientException"}),
+			},
+			Hydrate: getBatchJobDefinition,
+			Tags:    map[string]string{"service": "batch", "action": "DescribeJobDefinitions"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listBatchJobDefinitions,
+			Tags:    map[string]string{"service": "batch", "action": "DescribeJobDefinitions"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "job_definition_name", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(batchv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "job_definition_name",
+				Description: "The name of the job definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "job_definition_arn",
+				Description: "The Amazon Resource Name (ARN) of the job definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "revision",
+				Description: "The revision of the job definition.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the job definition. Can be ACTIVE or INACTIVE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of job definition. Can be container or multinode.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "container_orchestration_type",
+				Description: "The orchestration type of the compute environment. Can be ECS or EKS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "propagate_tags",
+				Description: "Specifies whether to propagate the tags from the job or job definition to the corresponding Amazon ECS task.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "scheduling_priority",
+				Description: "The scheduling priority of the job definition.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "platform_capabilities",
+				Description: "The platform capabilities required by the job definition. Can be EC2 or FARGATE.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "parameters",
+				Description: "Default parameters or parameter substitution placeholders that are set in the job definition.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "container_properties",
+				Description: "An object with properties specific to Amazon ECS-based jobs.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ecs_properties",
+				Description: "An object that contains the properties for the Amazon ECS resources of a job.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "eks_properties",
+				Description: "An object with properties that are specific to Amazon EKS-based jobs.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "node_properties",
+				Description: "An object with properties that are specific to multi-node parallel jobs.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "retry_strategy",
+				Description: "The retry strategy to use for failed jobs that are submitted with this job definition.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "timeout",
+				Description: "The timeout time for jobs that are submitted with this job definition.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("JobDefinitionName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("JobDefinitionArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listBatchJobDefinitions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := BatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_job_definition.listBatchJobDefinitions", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &batch.DescribeJobDefinitionsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	if d.EqualsQualString("job_definition_name") != "" {
+		input.JobDefinitionName = aws.String(d.EqualsQualString("job_definition_name"))
+	}
+	if d.EqualsQualString("status") != "" {
+		input.Status = aws.String(d.EqualsQualString("status"))
+	}
+
+	paginator := batch.NewDescribeJobDefinitionsPaginator(svc, input, func(o *batch.DescribeJobDefinitionsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_batch_job_definition.listBatchJobDefinitions", "api_error", err)
+			return nil, err
+		}
+
+		for _, definition := range output.JobDefinitions {
+			d.StreamListItem(ctx, definition)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBatchJobDefinition(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.EqualsQualString("job_definition_arn")
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := BatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_job_definition.getBatchJobDefinition", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &batch.DescribeJobDefinitionsInput{
+		JobDefinitions: []string{arn},
+	}
+
+	op, err := svc.DescribeJobDefinitions(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_job_definition.getBatchJobDefinition", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.JobDefinitions) > 0 {
+		return op.JobDefinitions[0], nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+
+	batchv1 "github.com/aws/aws-sdk-go/service/batch"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBatchJobQueue(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_batch_job_queue",
+		Description: "AWS Batch Job Queue",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("job_queue_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ClientException"}),
+			},
+			Hydrate: getBatchJobQueue,
+			Tags:    map[string]string{"service": "batch", "action": "DescribeJobQueues"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listBatchJobQueues,
+			Tags:    map[string]string{"service": "batch", "action": "DescribeJobQueues"},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(batchv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "job_queue_name",
+				Description: "The job queue name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "job_queue_arn",
+				Description: "The Amazon Resource Name (ARN) of the job queue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "Describes the ability of the queue to accept new jobs. Can be ENABLED or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the job queue, for example CREATING, UPDATING or VALID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_reason",
+				Description: "A short, human-readable string to provide more details for the current status of the job queue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "priority",
+				Description: "The priority of the job queue. Job queues with a higher priority are evaluated first when associated with the same compute environment.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "scheduling_policy_arn",
+				Description: "The Amazon Resource Name (ARN) of the scheduling policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compute_environment_order",
+				Description: "The compute environments that are attached to the job queue and the order that job placement is preferred.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "job_state_time_limit_actions",
+				Description: "The set of actions that Batch performs on jobs that remain at the head of the job queue in the specified state longer than specified times.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("JobQueueName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("JobQueueArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listBatchJobQueues(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := BatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_job_queue.listBatchJobQueues", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &batch.DescribeJobQueuesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := batch.NewDescribeJobQueuesPaginator(svc, input, func(o *batch.DescribeJobQueuesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_batch_job_queue.listBatchJobQueues", "api_error", err)
+			return nil, err
+		}
+
+		for _, queue := range output.JobQueues {
+			d.StreamListItem(ctx, queue)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBatchJobQueue(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("job_queue_name")
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := BatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_job_queue.getBatchJobQueue", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &batch.DescribeJobQueuesInput{
+		JobQueues: []string{name},
+	}
+
+	op, err := svc.DescribeJobQueues(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_job_queue.getBatchJobQueue", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.JobQueues) > 0 {
+		return op.JobQueues[0], nil
+	}
+	return nil, nil
+}
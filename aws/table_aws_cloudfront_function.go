@@ -19,7 +19,7 @@ func tableAwsCloudFrontFunction(_ context.Context) *plugin.Table {
 		Name:        "aws_cloudfront_function",
 		Description: "AWS CloudFront Function",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
+			KeyColumns: plugin.AllColumns([]string{"name", "stage"}),
 			IgnoreConfig: &plugin.IgnoreConfig{
 				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NoSuchFunctionExists"}),
 			},
@@ -29,12 +29,19 @@ func tableAwsCloudFrontFunction(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listCloudWatchFunctions,
 			Tags:    map[string]string{"service": "cloudfront", "action": "ListFunctions"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "stage", Require: plugin.Optional},
+			},
 		},
 		HydrateConfig: []plugin.HydrateConfig{
 			{
 				Func: getCloudFrontFunction,
 				Tags: map[string]string{"service": "cloudfront", "action": "GetFunction"},
 			},
+			{
+				Func: getCloudFrontFunctionCode,
+				Tags: map[string]string{"service": "cloudfront", "action": "GetFunction"},
+			},
 		},
 		Columns: awsRegionalColumns([]*plugin.Column{
 			{
@@ -56,6 +63,43 @@ func tableAwsCloudFrontFunction(_ context.Context) *plugin.Table {
 				Transform:   transform.FromField("Status", "FunctionSummary.Status"),
 				Hydrate:     getCloudFrontFunction,
 			},
+			{
+				Name:        "stage",
+				Description: "The stage that the function is in, either DEVELOPMENT or LIVE.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionMetadata.Stage", "FunctionSummary.FunctionMetadata.Stage"),
+			},
+			{
+				Name:        "runtime",
+				Description: "The function's runtime environment version.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionConfig.Runtime", "FunctionSummary.FunctionConfig.Runtime"),
+			},
+			{
+				Name:        "comment",
+				Description: "A comment to describe the function.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionConfig.Comment", "FunctionSummary.FunctionConfig.Comment"),
+			},
+			{
+				Name:        "created_time",
+				Description: "The date and time when the function was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("FunctionMetadata.CreatedTime", "FunctionSummary.FunctionMetadata.CreatedTime"),
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The date and time when the function was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("FunctionMetadata.LastModifiedTime", "FunctionSummary.FunctionMetadata.LastModifiedTime"),
+			},
+			{
+				Name:        "function_code",
+				Description: "The function code of the function in the given stage.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudFrontFunctionCode,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "e_tag",
 				Description: "The version identifier for the current version of the CloudFront function.",
@@ -121,6 +165,10 @@ func listCloudWatchFunctions(ctx context.Context, d *plugin.QueryData, h *plugin
 		MaxItems: &maxItems,
 	}
 
+	if d.EqualsQualString("stage") != "" {
+		input.Stage = types.FunctionStage(d.EqualsQualString("stage"))
+	}
+
 	// Paginator not available for the API
 	pagesLeft := true
 	for pagesLeft {
@@ -136,6 +184,11 @@ func listCloudWatchFunctions(ctx context.Context, d *plugin.QueryData, h *plugin
 
 		for _, function := range data.FunctionList.Items {
 			d.StreamListItem(ctx, function)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
 		}
 
 		if data.FunctionList.NextMarker != nil {
@@ -153,13 +206,17 @@ func listCloudWatchFunctions(ctx context.Context, d *plugin.QueryData, h *plugin
 
 func getCloudFrontFunction(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 
-	var name string
+	var name, stage string
 
 	if h.Item != nil {
 		function_summary := h.Item.(types.FunctionSummary)
 		name = *function_summary.Name
+		if function_summary.FunctionMetadata != nil {
+			stage = string(function_summary.FunctionMetadata.Stage)
+		}
 	} else {
 		name = d.EqualsQuals["name"].GetStringValue()
+		stage = d.EqualsQuals["stage"].GetStringValue()
 	}
 
 	if strings.TrimSpace(name) == "" {
@@ -177,6 +234,9 @@ func getCloudFrontFunction(ctx context.Context, d *plugin.QueryData, h *plugin.H
 	params := &cloudfront.DescribeFunctionInput{
 		Name: &name,
 	}
+	if stage != "" {
+		params.Stage = types.FunctionStage(stage)
+	}
 
 	// Get call
 	data, err := svc.DescribeFunction(ctx, params)
@@ -189,4 +249,42 @@ func getCloudFrontFunction(ctx context.Context, d *plugin.QueryData, h *plugin.H
 	return *data, nil
 }
 
+func getCloudFrontFunctionCode(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var summary *types.FunctionSummary
+	switch item := h.Item.(type) {
+	case types.FunctionSummary:
+		summary = &item
+	case cloudfront.DescribeFunctionOutput:
+		summary = item.FunctionSummary
+	}
+
+	if summary == nil || summary.Name == nil {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := CloudFrontClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_function.getCloudFrontFunctionCode", "client_error", err)
+		return nil, err
+	}
+
+	// Build the params
+	params := &cloudfront.GetFunctionInput{
+		Name: summary.Name,
+	}
+	if summary.FunctionMetadata != nil {
+		params.Stage = summary.FunctionMetadata.Stage
+	}
+
+	// Get call
+	data, err := svc.GetFunction(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_function.getCloudFrontFunctionCode", "api_error", err)
+		return nil, err
+	}
+
+	return string(data.FunctionCode), nil
+}
+
 //// TRANSFORM FUNCTION
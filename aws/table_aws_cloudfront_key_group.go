@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudFrontKeyGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudfront_key_group",
+		Description: "AWS CloudFront Key Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NoSuchResource"}),
+			},
+			Hydrate: getCloudFrontKeyGroup,
+			Tags:    map[string]string{"service": "cloudfront", "action": "GetKeyGroup"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudFrontKeyGroups,
+			Tags:    map[string]string{"service": "cloudfront", "action": "ListKeyGroups"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getCloudFrontKeyGroup,
+				Tags: map[string]string{"service": "cloudfront", "action": "GetKeyGroup"},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The identifier for the key group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("KeyGroup.Id"),
+			},
+			{
+				Name:        "name",
+				Description: "A name to identify the key group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("KeyGroup.KeyGroupConfig.Name"),
+			},
+			{
+				Name:        "comment",
+				Description: "A comment to describe the key group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("KeyGroup.KeyGroupConfig.Comment"),
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The date and time when the key group was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("KeyGroup.LastModifiedTime"),
+			},
+			{
+				Name:        "etag",
+				Description: "The identifier for this version of the key group.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudFrontKeyGroup,
+				Transform:   transform.FromField("ETag"),
+			},
+			{
+				Name:        "items",
+				Description: "A list of the identifiers of the public keys in the key group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("KeyGroup.KeyGroupConfig.Items"),
+			},
+
+			//  Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("KeyGroup.KeyGroupConfig.Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudFrontKeyGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get client
+	svc, err := CloudFrontClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_key_group.listCloudFrontKeyGroups", "client_error", err)
+		return nil, err
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &cloudfront.ListKeyGroupsInput{
+		MaxItems: &maxItems,
+	}
+
+	// Paginator not available for the API
+	pagesLeft := true
+	for pagesLeft {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		data, err := svc.ListKeyGroups(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudfront_key_group.listCloudFrontKeyGroups", "api_error", err)
+			return nil, err
+		}
+
+		if data.KeyGroupList == nil {
+			return nil, nil
+		}
+
+		for _, keyGroup := range data.KeyGroupList.Items {
+			d.StreamListItem(ctx, keyGroup)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if data.KeyGroupList.NextMarker != nil {
+			input.Marker = data.KeyGroupList.NextMarker
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudFrontKeyGroup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		id = *h.Item.(types.KeyGroupSummary).KeyGroup.Id
+	} else {
+		id = d.EqualsQuals["id"].GetStringValue()
+	}
+
+	if strings.TrimSpace(id) == "" {
+		return nil, nil
+	}
+
+	// Get client
+	svc, err := CloudFrontClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_key_group.getCloudFrontKeyGroup", "client_error", err)
+		return nil, err
+	}
+
+	params := &cloudfront.GetKeyGroupInput{
+		Id: &id,
+	}
+
+	op, err := svc.GetKeyGroup(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_key_group.getCloudFrontKeyGroup", "api_error", err)
+		return nil, err
+	}
+
+	return *op, nil
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
@@ -17,6 +18,14 @@ func tableAwsCloudFrontResponseHeadersPolicy(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:        "aws_cloudfront_response_headers_policy",
 		Description: "AWS Cloudfront Response Headers Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NoSuchResponseHeadersPolicy"}),
+			},
+			Hydrate: getCloudFrontResponseHeadersPolicy,
+			Tags:    map[string]string{"service": "cloudfront", "action": "GetResponseHeadersPolicy"},
+		},
 		List: &plugin.ListConfig{
 			KeyColumns: []*plugin.KeyColumn{
 				{
@@ -29,7 +38,7 @@ func tableAwsCloudFrontResponseHeadersPolicy(_ context.Context) *plugin.Table {
 		},
 		HydrateConfig: []plugin.HydrateConfig{
 			{
-				Func: getETagValue,
+				Func: getCloudFrontResponseHeadersPolicy,
 				Tags: map[string]string{"service": "cloudfront", "action": "GetResponseHeadersPolicy"},
 			},
 		},
@@ -53,6 +62,12 @@ func tableAwsCloudFrontResponseHeadersPolicy(_ context.Context) *plugin.Table {
 				Hydrate:     getAccountARN,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "comment",
+				Description: "A comment to describe the response headers policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResponseHeadersPolicy.ResponseHeadersPolicyConfig.Comment"),
+			},
 			{
 				Name:        "last_modified_time",
 				Description: "The date and time when the response headers policy was last modified.",
@@ -68,7 +83,7 @@ func tableAwsCloudFrontResponseHeadersPolicy(_ context.Context) *plugin.Table {
 				Name:        "etag",
 				Description: "The version identifier for the current version of the response headers policy.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getETagValue,
+				Hydrate:     getCloudFrontResponseHeadersPolicy,
 				Transform:   transform.FromField("ETag"),
 			},
 			{
@@ -77,6 +92,36 @@ func tableAwsCloudFrontResponseHeadersPolicy(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("ResponseHeadersPolicy.ResponseHeadersPolicyConfig"),
 			},
+			{
+				Name:        "security_headers_config",
+				Description: "A configuration for a set of security-related HTTP response headers.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResponseHeadersPolicy.ResponseHeadersPolicyConfig.SecurityHeadersConfig"),
+			},
+			{
+				Name:        "cors_config",
+				Description: "A configuration for a set of HTTP response headers that are used for cross-origin resource sharing (CORS).",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResponseHeadersPolicy.ResponseHeadersPolicyConfig.CorsConfig"),
+			},
+			{
+				Name:        "custom_headers_config",
+				Description: "A configuration for a set of custom HTTP response headers.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResponseHeadersPolicy.ResponseHeadersPolicyConfig.CustomHeadersConfig"),
+			},
+			{
+				Name:        "remove_headers_config",
+				Description: "A configuration for a set of HTTP headers to remove from the HTTP response.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResponseHeadersPolicy.ResponseHeadersPolicyConfig.RemoveHeadersConfig"),
+			},
+			{
+				Name:        "server_timing_headers_config",
+				Description: "A configuration for enabling the Server-Timing header in HTTP responses sent from CloudFront.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResponseHeadersPolicy.ResponseHeadersPolicyConfig.ServerTimingHeadersConfig"),
+			},
 			// Steampipe standard columns
 			{
 				Name:        "title",
@@ -145,6 +190,11 @@ func listCloudFrontResponseHeadersPolicies(ctx context.Context, d *plugin.QueryD
 
 		for _, policy := range data.ResponseHeadersPolicyList.Items {
 			d.StreamListItem(ctx, policy)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
 		}
 
 		if data.ResponseHeadersPolicyList.NextMarker != nil {
@@ -160,14 +210,22 @@ func listCloudFrontResponseHeadersPolicies(ctx context.Context, d *plugin.QueryD
 
 //// HYDRATE FUNCTIONS
 
-func getETagValue(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	item := h.Item.(types.ResponseHeadersPolicySummary)
-	id := *item.ResponseHeadersPolicy.Id
+func getCloudFrontResponseHeadersPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		id = *h.Item.(types.ResponseHeadersPolicySummary).ResponseHeadersPolicy.Id
+	} else {
+		id = d.EqualsQualString("id")
+	}
+
+	if strings.TrimSpace(id) == "" {
+		return nil, nil
+	}
 
 	// Get client
 	svc, err := CloudFrontClient(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("aws_cloudfront_response_headers_policy.getETagValue", "client_error", err)
+		plugin.Logger(ctx).Error("aws_cloudfront_response_headers_policy.getCloudFrontResponseHeadersPolicy", "client_error", err)
 		return nil, err
 	}
 
@@ -178,7 +236,7 @@ func getETagValue(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDat
 	data, err := svc.GetResponseHeadersPolicy(ctx, params)
 
 	if err != nil {
-		plugin.Logger(ctx).Error("aws_cloudfront_response_headers_policy.getETagValue", "api_error", err)
+		plugin.Logger(ctx).Error("aws_cloudfront_response_headers_policy.getCloudFrontResponseHeadersPolicy", "api_error", err)
 		return nil, err
 	}
 
@@ -197,9 +255,12 @@ func getAccountARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	commonColumnData := response.(*awsCommonColumnData)
 
 	var id string
-
-	item := h.Item.(types.ResponseHeadersPolicySummary)
-	id = *item.ResponseHeadersPolicy.Id
+	switch item := h.Item.(type) {
+	case types.ResponseHeadersPolicySummary:
+		id = *item.ResponseHeadersPolicy.Id
+	case *cloudfront.GetResponseHeadersPolicyOutput:
+		id = *item.ResponseHeadersPolicy.Id
+	}
 
 	arn := "arn:" + commonColumnData.Partition + ":cloudfront::" + commonColumnData.AccountId + ":response-headers-policy/" + id
 
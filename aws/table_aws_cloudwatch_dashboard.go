@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudWatchDashboard(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_dashboard",
+		Description: "AWS CloudWatch Dashboard",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFound"}),
+			},
+			Hydrate: getCloudWatchDashboard,
+			Tags:    map[string]string{"service": "cloudwatch", "action": "GetDashboard"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudWatchDashboards,
+			Tags:    map[string]string{"service": "cloudwatch", "action": "ListDashboards"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getCloudWatchDashboard,
+				Tags: map[string]string{"service": "cloudwatch", "action": "GetDashboard"},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the dashboard.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DashboardName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the dashboard.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DashboardArn"),
+			},
+			{
+				Name:        "last_modified",
+				Description: "The time stamp of when the dashboard was last modified, either by an API call or through the console.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "size",
+				Description: "The size of the dashboard, in bytes.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "dashboard_body",
+				Description: "The detailed information about the dashboard, including what widgets are included and their location on the dashboard.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchDashboard,
+				Transform:   transform.FromField("DashboardBody").Transform(transform.UnmarshalYAML),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DashboardName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DashboardArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudWatchDashboards(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CloudWatchDashboardClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_dashboard.listCloudWatchDashboards", "get_client_error", err)
+		return nil, err
+	}
+
+	// ListDashboards does not support a page size
+	paginator := cloudwatch.NewListDashboardsPaginator(svc, &cloudwatch.ListDashboardsInput{}, func(o *cloudwatch.ListDashboardsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudwatch_dashboard.listCloudWatchDashboards", "api_error", err)
+			return nil, err
+		}
+		for _, dashboard := range output.DashboardEntries {
+			d.StreamListItem(ctx, dashboard)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudWatchDashboard(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name string
+	if h.Item != nil {
+		name = *h.Item.(types.DashboardEntry).DashboardName
+	} else {
+		name = d.EqualsQuals["name"].GetStringValue()
+	}
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CloudWatchDashboardClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_dashboard.getCloudWatchDashboard", "get_client_error", err)
+		return nil, err
+	}
+
+	params := &cloudwatch.GetDashboardInput{
+		DashboardName: aws.String(name),
+	}
+
+	op, err := svc.GetDashboard(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_dashboard.getCloudWatchDashboard", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
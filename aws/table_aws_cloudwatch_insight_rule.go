@@ -0,0 +1,308 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	cloudwatchv1 "github.com/aws/aws-sdk-go/service/cloudwatch"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudWatchInsightRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_insight_rule",
+		Description: "AWS CloudWatch Insight Rule",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getCloudWatchInsightRule,
+			Tags:       map[string]string{"service": "cloudwatch", "action": "DescribeInsightRules"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudWatchInsightRules,
+			Tags:    map[string]string{"service": "cloudwatch", "action": "DescribeInsightRules"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getCloudWatchInsightRuleTags,
+				Tags: map[string]string{"service": "cloudwatch", "action": "ListTagsForResource"},
+			},
+			{
+				Func: getCloudWatchInsightRuleReport,
+				Tags: map[string]string{"service": "cloudwatch", "action": "GetInsightRuleReport"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(cloudwatchv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the rule.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudWatchInsightRuleArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "state",
+				Description: "Indicates whether the rule is enabled or disabled.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "schema",
+				Description: "The version of the rule syntax used by the rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "managed_rule",
+				Description: "An optional built-in rule that Amazon Web Services manages.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "apply_on_transformed_logs",
+				Description: "Displays whether the rule is evaluated on the transformed versions of logs.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "definition",
+				Description: "The definition of the rule, as a JSON object.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Definition").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "key_labels",
+				Description: "An array of the strings used as the keys for the rule in the last hour.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchInsightRuleReport,
+			},
+			{
+				Name:        "contributors",
+				Description: "An array of the unique contributors found by the rule in the last hour, ordered by their contribution.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchInsightRuleReport,
+			},
+			{
+				Name:        "tags_src",
+				Description: "The list of tags associated with the rule.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchInsightRuleTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchInsightRuleTags,
+				Transform:   transform.From(getCloudWatchInsightRuleTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchInsightRuleArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudWatchInsightRules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_insight_rule.listCloudWatchInsightRules", "get_client_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(500)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	params := &cloudwatch.DescribeInsightRulesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := cloudwatch.NewDescribeInsightRulesPaginator(svc, params, func(o *cloudwatch.DescribeInsightRulesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudwatch_insight_rule.listCloudWatchInsightRules", "api_error", err)
+			return nil, err
+		}
+		for _, rule := range output.InsightRules {
+			d.StreamListItem(ctx, rule)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudWatchInsightRule(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQuals["name"].GetStringValue()
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_insight_rule.getCloudWatchInsightRule", "get_client_error", err)
+		return nil, err
+	}
+
+	// DescribeInsightRules does not support filtering by name, so page through the rules until a match is found
+	paginator := cloudwatch.NewDescribeInsightRulesPaginator(svc, &cloudwatch.DescribeInsightRulesInput{}, func(o *cloudwatch.DescribeInsightRulesPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudwatch_insight_rule.getCloudWatchInsightRule", "api_error", err)
+			return nil, err
+		}
+		for _, rule := range output.InsightRules {
+			if aws.ToString(rule.Name) == name {
+				return rule, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func getCloudWatchInsightRuleTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn, err := getCloudWatchInsightRuleArn(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_insight_rule.getCloudWatchInsightRuleTags", "client_error", err)
+		return nil, err
+	}
+
+	params := &cloudwatch.ListTagsForResourceInput{
+		ResourceARN: aws.String(arn.(string)),
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_insight_rule.getCloudWatchInsightRuleTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getCloudWatchInsightRuleReport(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	rule := h.Item.(types.InsightRule)
+
+	// Create session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_insight_rule.getCloudWatchInsightRuleReport", "client_error", err)
+		return nil, err
+	}
+
+	// Report on the contributors seen over the last hour
+	endTime := time.Now()
+	params := &cloudwatch.GetInsightRuleReportInput{
+		RuleName:  rule.Name,
+		StartTime: aws.Time(endTime.Add(-1 * time.Hour)),
+		EndTime:   aws.Time(endTime),
+		Period:    aws.Int32(300),
+	}
+
+	op, err := svc.GetInsightRuleReport(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_insight_rule.getCloudWatchInsightRuleReport", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getCloudWatchInsightRuleArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+	rule := h.Item.(types.InsightRule)
+
+	// Get common columns
+	c, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_insight_rule.getCloudWatchInsightRuleArn", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+
+	// arn:aws:cloudwatch:region:account-id:insight-rule/insight-rule-name
+	arn := "arn:" + commonColumnData.Partition + ":cloudwatch:" + region + ":" + commonColumnData.AccountId + ":insight-rule/" + *rule.Name
+
+	return arn, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getCloudWatchInsightRuleTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tagList := d.HydrateItem.(*cloudwatch.ListTagsForResourceOutput)
+
+	if len(tagList.Tags) == 0 {
+		return nil, nil
+	}
+
+	// Mapping the resource tags inside turbotTags
+	turbotTagsMap := map[string]string{}
+	for _, i := range tagList.Tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return turbotTagsMap, nil
+}
@@ -2,11 +2,13 @@ package aws
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/aws/smithy-go"
 
 	cloudwatchlogsv1 "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 
@@ -159,6 +161,13 @@ func listCloudwatchLogGroups(ctx context.Context, d *plugin.QueryData, _ *plugin
 
 	name := d.EqualsQualString("name")
 
+	// This function is also the parent hydrate of the log stream and
+	// subscription filter tables, where name refers to the child resource and
+	// the log group is identified by log_group_name instead
+	if d.Table.Name != "aws_cloudwatch_log_group" {
+		name = d.EqualsQualString("log_group_name")
+	}
+
 	maxItems := int32(50)
 
 	// Reduce the basic request limit down if the user has only requested a small number
@@ -260,10 +269,21 @@ func getCloudwatchLogGroupDataProtectionPolicy(ctx context.Context, d *plugin.Qu
 	// Get data protection policy
 	dataProtectionPolicyData, err := svc.GetDataProtectionPolicy(ctx, params)
 	if err != nil {
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			if ae.ErrorCode() == "ResourceNotFoundException" {
+				return nil, nil
+			}
+		}
 		plugin.Logger(ctx).Error("aws_cloudwatch_log_group.getCloudwatchLogGroupDataProtectionPolicy", "api_error", err)
 		return nil, err
 	}
 
+	// Log groups without a data protection policy return an empty policy document
+	if dataProtectionPolicyData.PolicyDocument == nil || *dataProtectionPolicyData.PolicyDocument == "" {
+		return nil, nil
+	}
+
 	return dataProtectionPolicyData, nil
 }
 
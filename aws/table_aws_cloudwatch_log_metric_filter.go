@@ -19,9 +19,12 @@ func tableAwsCloudwatchLogMetricFilter(_ context.Context) *plugin.Table {
 		Name:        "aws_cloudwatch_log_metric_filter",
 		Description: "AWS CloudWatch Log Metric Filter",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getCloudwatchLogMetricFilter,
-			Tags:       map[string]string{"service": "logs", "action": "DescribeMetricFilters"},
+			KeyColumns: plugin.AllColumns([]string{"name", "log_group_name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getCloudwatchLogMetricFilter,
+			Tags:    map[string]string{"service": "logs", "action": "DescribeMetricFilters"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCloudwatchLogMetricFilters,
@@ -90,6 +93,11 @@ func tableAwsCloudwatchLogMetricFilter(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromP(logMetricTransformationsData, "MetricValue"),
 			},
+			{
+				Name:        "metric_transformations",
+				Description: "The metric transformations of the metric filter, including the metric name, namespace, value, default value, dimensions and unit",
+				Type:        proto.ColumnType_JSON,
+			},
 
 			//// Steampipe Standard Columns
 
@@ -186,6 +194,7 @@ func listCloudwatchLogMetricFilters(ctx context.Context, d *plugin.QueryData, _
 
 func getCloudwatchLogMetricFilter(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	name := d.EqualsQuals["name"].GetStringValue()
+	logGroupName := d.EqualsQuals["log_group_name"].GetStringValue()
 
 	// Create session
 	svc, err := CloudWatchLogsClient(ctx, d)
@@ -196,6 +205,7 @@ func getCloudwatchLogMetricFilter(ctx context.Context, d *plugin.QueryData, _ *p
 
 	params := &cloudwatchlogs.DescribeMetricFiltersInput{
 		FilterNamePrefix: &name,
+		LogGroupName:     &logGroupName,
 	}
 
 	// execute list call
@@ -53,8 +53,9 @@ func tableAwsDynamoDBTable(_ context.Context) *plugin.Table {
 				Tags: map[string]string{"service": "dynamodb", "action": "ListTagsOfResource"},
 			},
 			{
-				Func: getDescribeContinuousBackups,
-				Tags: map[string]string{"service": "dynamodb", "action": "DescribeContinuousBackups"},
+				Func:    getDescribeContinuousBackups,
+				Depends: []plugin.HydrateFunc{getDynamoDBTable},
+				Tags:    map[string]string{"service": "dynamodb", "action": "DescribeContinuousBackups"},
 			},
 			{
 				Func: getTableStreamingDestination,
@@ -229,6 +230,13 @@ func tableAwsDynamoDBTable(_ context.Context) *plugin.Table {
 				Hydrate:     getTableStreamingDestination,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "kinesis_streaming_destinations",
+				Description: "The Kinesis data streams that the table replicates changes to, along with the status of each destination.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getTableStreamingDestination,
+				Transform:   transform.From(getTableKinesisStreamingDestinations),
+			},
 			{
 				Name:        "point_in_time_recovery_description",
 				Description: "The description of the point in time recovery settings applied to the table.",
@@ -236,6 +244,27 @@ func tableAwsDynamoDBTable(_ context.Context) *plugin.Table {
 				Hydrate:     getDescribeContinuousBackups,
 				Transform:   transform.FromField("ContinuousBackupsDescription.PointInTimeRecoveryDescription"),
 			},
+			{
+				Name:        "point_in_time_recovery_status",
+				Description: "The current state of point in time recovery. PointInTimeRecoveryStatus can be one of the following states: ENABLED, DISABLED.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDescribeContinuousBackups,
+				Transform:   transform.FromField("ContinuousBackupsDescription.PointInTimeRecoveryDescription.PointInTimeRecoveryStatus"),
+			},
+			{
+				Name:        "earliest_restorable_date_time",
+				Description: "Specifies the earliest point in time you can restore your table to.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getDescribeContinuousBackups,
+				Transform:   transform.FromField("ContinuousBackupsDescription.PointInTimeRecoveryDescription.EarliestRestorableDateTime"),
+			},
+			{
+				Name:        "latest_restorable_date_time",
+				Description: "LatestRestorableDateTime is typically 5 minutes before the current time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getDescribeContinuousBackups,
+				Transform:   transform.FromField("ContinuousBackupsDescription.PointInTimeRecoveryDescription.LatestRestorableDateTime"),
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags assigned to the table.",
@@ -380,6 +409,18 @@ func getDynamoDBTable(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 func getDescribeContinuousBackups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	table := h.Item.(types.TableDescription)
 
+	// The list call only returns table names, so the table status comes from
+	// the DescribeTable result
+	status := table.TableStatus
+	if describe, ok := h.HydrateResults["getDynamoDBTable"].(types.TableDescription); ok {
+		status = describe.TableStatus
+	}
+
+	// Continuous backup settings are not available for tables that are being deleted
+	if status == types.TableStatusDeleting {
+		return nil, nil
+	}
+
 	// Create Session
 	svc, err := DynamoDBClient(ctx, d)
 	if err != nil {
@@ -497,6 +538,16 @@ func getTableBillingMode(_ context.Context, d *transform.TransformData) (interfa
 	return billingMode, nil
 }
 
+// getTableKinesisStreamingDestinations returns an empty list rather than null for tables without a Kinesis streaming destination
+func getTableKinesisStreamingDestinations(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	op, ok := d.HydrateItem.(*dynamodb.DescribeKinesisStreamingDestinationOutput)
+	if !ok || op == nil || op.KinesisDataStreamDestinations == nil {
+		return []types.KinesisDataStreamDestination{}, nil
+	}
+
+	return op.KinesisDataStreamDestinations, nil
+}
+
 func getTableTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	tags := d.HydrateItem.([]types.Tag)
 
@@ -30,6 +30,12 @@ func tableAwsDynamoDBTableExport(_ context.Context) *plugin.Table {
 			ParentHydrate: listDynamoDBTables,
 			Hydrate:       listTableExports,
 			Tags:          map[string]string{"service": "dynamodb", "action": "ListExports"},
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "table_arn",
+					Require: plugin.Optional,
+				},
+			},
 		},
 		HydrateConfig: []plugin.HydrateConfig{
 			{
@@ -191,6 +197,11 @@ func listTableExports(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	commonColumnData := c.(*awsCommonColumnData)
 	tableArn := "arn:" + commonColumnData.Partition + ":dynamodb:" + region + ":" + commonColumnData.AccountId + ":table/" + *tableName
 
+	// Limit API calls to the table passed in the table_arn qual
+	if d.EqualsQualString("table_arn") != "" && d.EqualsQualString("table_arn") != tableArn {
+		return nil, nil
+	}
+
 	// Create Session
 	svc, err := DynamoDBClient(ctx, d)
 	if err != nil {
@@ -0,0 +1,340 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	ec2v1 "github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2Fleet(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_fleet",
+		Description: "AWS EC2 Fleet",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("fleet_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidFleetId.NotFound", "InvalidFleetId.Malformed", "InvalidParameterValue"}),
+			},
+			Hydrate: getEc2Fleet,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeFleets"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2Fleets,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeFleets"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "activity_status", Require: plugin.Optional},
+				{Name: "fleet_state", Require: plugin.Optional},
+				{Name: "type", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(ec2v1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "fleet_id",
+				Description: "The ID of the EC2 Fleet.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the EC2 Fleet.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getEc2FleetARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "fleet_state",
+				Description: "The state of the EC2 Fleet.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of request. Can be one of request, maintain or instant.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "activity_status",
+				Description: "The progress of the EC2 Fleet. Can be one of error, pending_fulfillment, pending_termination or fulfilled.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation date and time of the EC2 Fleet.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "fulfilled_capacity",
+				Description: "The number of units fulfilled by this request compared to the set target capacity.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "fulfilled_on_demand_capacity",
+				Description: "The number of units fulfilled by this request compared to the set target On-Demand capacity.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "excess_capacity_termination_policy",
+				Description: "Indicates whether running instances should be terminated if the target capacity of the EC2 Fleet is decreased below the current size of the EC2 Fleet.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "replace_unhealthy_instances",
+				Description: "Indicates whether EC2 Fleet should replace unhealthy Spot Instances.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "terminate_instances_with_expiration",
+				Description: "Indicates whether running instances should be terminated when the EC2 Fleet expires.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "valid_from",
+				Description: "The start date and time of the request.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "valid_until",
+				Description: "The end date and time of the request.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "context",
+				Description: "Reserved.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_capacity_specification",
+				Description: "The number of units to request.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "launch_template_configs",
+				Description: "The launch template and overrides.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "spot_options",
+				Description: "The configuration of Spot Instances in an EC2 Fleet.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "on_demand_options",
+				Description: "The allocation strategy of On-Demand Instances in an EC2 Fleet.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "instances",
+				Description: "Information about the instances that were launched by the fleet. Valid only when type is set to instant.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "errors",
+				Description: "Information about the instances that could not be launched by the fleet. Valid only when type is set to instant.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the EC2 Fleet.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getEc2FleetTurbotTitle),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getEc2FleetTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2FleetARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2Fleets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_fleet.listEc2Fleets", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &ec2.DescribeFleetsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	filters := buildEc2FleetFilter(d.EqualsQuals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	paginator := ec2.NewDescribeFleetsPaginator(svc, input, func(o *ec2.DescribeFleetsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_fleet.listEc2Fleets", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Fleets {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2Fleet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	fleetID := d.EqualsQualString("fleet_id")
+
+	// Empty check
+	if fleetID == "" {
+		return nil, nil
+	}
+
+	// create service
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_fleet.getEc2Fleet", "connection_error", err)
+		return nil, err
+	}
+
+	params := &ec2.DescribeFleetsInput{
+		FleetIds: []string{fleetID},
+	}
+
+	op, err := svc.DescribeFleets(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_fleet.getEc2Fleet", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.Fleets) > 0 {
+		return op.Fleets[0], nil
+	}
+	return nil, nil
+}
+
+func getEc2FleetARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+	fleet := h.Item.(types.FleetData)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_fleet.getEc2FleetARN", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	arn := "arn:" + commonColumnData.Partition + ":ec2:" + region + ":" + commonColumnData.AccountId + ":fleet/" + *fleet.FleetId
+
+	return arn, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getEc2FleetTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(types.FleetData)
+	if data.Tags == nil {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range data.Tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return &turbotTagsMap, nil
+}
+
+func getEc2FleetTurbotTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(types.FleetData)
+	title := data.FleetId
+	if data.Tags != nil {
+		for _, i := range data.Tags {
+			if *i.Key == "Name" {
+				title = i.Value
+			}
+		}
+	}
+	return title, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// Build EC2 fleet list call input filter
+func buildEc2FleetFilter(equalQuals plugin.KeyColumnEqualsQualMap) []types.Filter {
+	filters := make([]types.Filter, 0)
+
+	filterQuals := map[string]string{
+		"activity_status": "activity-status",
+		"fleet_state":     "fleet-state",
+		"type":            "type",
+	}
+
+	for columnName, filterName := range filterQuals {
+		if equalQuals[columnName] != nil {
+			filter := types.Filter{
+				Name: aws.String(filterName),
+			}
+			value := equalQuals[columnName]
+			if value.GetStringValue() != "" {
+				filter.Values = []string{equalQuals[columnName].GetStringValue()}
+			}
+			filters = append(filters, filter)
+		}
+	}
+	return filters
+}
@@ -0,0 +1,291 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	ec2v1 "github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2InstanceConnectEndpoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_instance_connect_endpoint",
+		Description: "AWS EC2 Instance Connect Endpoint",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("instance_connect_endpoint_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidInstanceConnectEndpointId.NotFound", "InvalidInstanceConnectEndpointId.Malformed", "InvalidParameterValue"}),
+			},
+			Hydrate: getEc2InstanceConnectEndpoint,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeInstanceConnectEndpoints"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2InstanceConnectEndpoints,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeInstanceConnectEndpoints"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "state", Require: plugin.Optional},
+				{Name: "subnet_id", Require: plugin.Optional},
+				{Name: "vpc_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(ec2v1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "instance_connect_endpoint_id",
+				Description: "The ID of the EC2 Instance Connect Endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_connect_endpoint_arn",
+				Description: "The Amazon Resource Name (ARN) of the EC2 Instance Connect Endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the EC2 Instance Connect Endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state_message",
+				Description: "The message for the current state of the EC2 Instance Connect Endpoint. Can include a failure message.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the EC2 Instance Connect Endpoint was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The ID of the Amazon Web Services account that created the EC2 Instance Connect Endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_zone",
+				Description: "The Availability Zone of the EC2 Instance Connect Endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vpc_id",
+				Description: "The ID of the VPC in which the EC2 Instance Connect Endpoint was created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subnet_id",
+				Description: "The ID of the subnet in which the EC2 Instance Connect Endpoint was created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dns_name",
+				Description: "The DNS name of the EC2 Instance Connect Endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "fips_dns_name",
+				Description: "The FIPS DNS name of the EC2 Instance Connect Endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "preserve_client_ip",
+				Description: "Indicates whether your client's IP address is preserved as the source.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "network_interface_ids",
+				Description: "The ID of the elastic network interface that Amazon EC2 automatically created when creating the EC2 Instance Connect Endpoint.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "security_group_ids",
+				Description: "The security groups associated with the endpoint.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the EC2 Instance Connect Endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getEc2InstanceConnectEndpointTurbotTitle),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getEc2InstanceConnectEndpointTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("InstanceConnectEndpointArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2InstanceConnectEndpoints(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_instance_connect_endpoint.listEc2InstanceConnectEndpoints", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(50)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &ec2.DescribeInstanceConnectEndpointsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	filters := buildEc2InstanceConnectEndpointFilter(d.EqualsQuals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	paginator := ec2.NewDescribeInstanceConnectEndpointsPaginator(svc, input, func(o *ec2.DescribeInstanceConnectEndpointsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_instance_connect_endpoint.listEc2InstanceConnectEndpoints", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.InstanceConnectEndpoints {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2InstanceConnectEndpoint(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	endpointID := d.EqualsQualString("instance_connect_endpoint_id")
+
+	// Empty check
+	if endpointID == "" {
+		return nil, nil
+	}
+
+	// create service
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_instance_connect_endpoint.getEc2InstanceConnectEndpoint", "connection_error", err)
+		return nil, err
+	}
+
+	params := &ec2.DescribeInstanceConnectEndpointsInput{
+		InstanceConnectEndpointIds: []string{endpointID},
+	}
+
+	op, err := svc.DescribeInstanceConnectEndpoints(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_instance_connect_endpoint.getEc2InstanceConnectEndpoint", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.InstanceConnectEndpoints) > 0 {
+		return op.InstanceConnectEndpoints[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getEc2InstanceConnectEndpointTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(types.Ec2InstanceConnectEndpoint)
+	if data.Tags == nil {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range data.Tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return &turbotTagsMap, nil
+}
+
+func getEc2InstanceConnectEndpointTurbotTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(types.Ec2InstanceConnectEndpoint)
+	title := data.InstanceConnectEndpointId
+	if data.Tags != nil {
+		for _, i := range data.Tags {
+			if *i.Key == "Name" {
+				title = i.Value
+			}
+		}
+	}
+	return title, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// Build EC2 instance connect endpoint list call input filter
+func buildEc2InstanceConnectEndpointFilter(equalQuals plugin.KeyColumnEqualsQualMap) []types.Filter {
+	filters := make([]types.Filter, 0)
+
+	filterQuals := map[string]string{
+		"state":     "state",
+		"subnet_id": "subnet-id",
+		"vpc_id":    "vpc-id",
+	}
+
+	for columnName, filterName := range filterQuals {
+		if equalQuals[columnName] != nil {
+			filter := types.Filter{
+				Name: aws.String(filterName),
+			}
+			value := equalQuals[columnName]
+			if value.GetStringValue() != "" {
+				filter.Values = []string{equalQuals[columnName].GetStringValue()}
+			}
+			filters = append(filters, filter)
+		}
+	}
+	return filters
+}
@@ -33,6 +33,20 @@ func tableAwsEc2ManagedPrefixList(_ context.Context) *plugin.Table {
 				{Name: "owner_id", Require: plugin.Optional},
 			},
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidPrefixListID.NotFound", "InvalidPrefixListID.Malformed", "InvalidAction", "InvalidRequest", "UnsupportedOperation"}),
+			},
+			Hydrate: getManagedPrefixList,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeManagedPrefixLists"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getManagedPrefixListEntries,
+				Tags: map[string]string{"service": "ec2", "action": "GetManagedPrefixListEntries"},
+			},
+		},
 		GetMatrixItemFunc: SupportedRegionMatrix(ec2v1.EndpointsID),
 		Columns: awsRegionalColumns([]*plugin.Column{
 			{
@@ -83,6 +97,13 @@ func tableAwsEc2ManagedPrefixList(_ context.Context) *plugin.Table {
 				Description: "The version of the prefix list.",
 				Type:        proto.ColumnType_INT,
 			},
+			{
+				Name:        "entries",
+				Description: "The CIDR blocks and descriptions of the entries in the prefix list.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getManagedPrefixListEntries,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "tags_src",
 				Description: "The tags for the prefix list.",
@@ -204,6 +225,75 @@ func listManagedPrefixList(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	return nil, nil
 }
 
+//// HYDRATE FUNCTIONS
+
+func getManagedPrefixList(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	prefixListID := d.EqualsQualString("id")
+
+	// Empty check
+	if prefixListID == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_managed_prefix_list.getManagedPrefixList", "connection_error", err)
+		return nil, err
+	}
+
+	params := &ec2.DescribeManagedPrefixListsInput{
+		PrefixListIds: []string{prefixListID},
+	}
+
+	op, err := svc.DescribeManagedPrefixLists(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_managed_prefix_list.getManagedPrefixList", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.PrefixLists) > 0 {
+		return op.PrefixLists[0], nil
+	}
+	return nil, nil
+}
+
+func getManagedPrefixListEntries(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	prefixList := h.Item.(types.ManagedPrefixList)
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_managed_prefix_list.getManagedPrefixListEntries", "connection_error", err)
+		return nil, err
+	}
+
+	params := &ec2.GetManagedPrefixListEntriesInput{
+		MaxResults:   aws.Int32(100),
+		PrefixListId: prefixList.PrefixListId,
+	}
+
+	paginator := ec2.NewGetManagedPrefixListEntriesPaginator(svc, params, func(o *ec2.GetManagedPrefixListEntriesPaginatorOptions) {
+		o.Limit = 100
+		o.StopOnDuplicateToken = true
+	})
+
+	entries := []types.PrefixListEntry{}
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_managed_prefix_list.getManagedPrefixListEntries", "api_error", err)
+			return nil, err
+		}
+		entries = append(entries, output.Entries...)
+	}
+
+	return entries, nil
+}
+
 //// TRANSFORM FUNCTION
 
 func prefixListTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -0,0 +1,275 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	ec2v1 "github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2NetworkInsightsAnalysis(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_network_insights_analysis",
+		Description: "AWS EC2 Network Insights Analysis",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("network_insights_analysis_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidNetworkInsightsAnalysisId.NotFound", "InvalidNetworkInsightsAnalysisId.Malformed", "InvalidParameterValue"}),
+			},
+			Hydrate: getEc2NetworkInsightsAnalysis,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeNetworkInsightsAnalyses"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2NetworkInsightsAnalyses,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeNetworkInsightsAnalyses"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "network_insights_path_id", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(ec2v1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "network_insights_analysis_id",
+				Description: "The ID of the network insights analysis.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "network_insights_analysis_arn",
+				Description: "The Amazon Resource Name (ARN) of the network insights analysis.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "network_insights_path_id",
+				Description: "The ID of the path.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the network insights analysis. Can be running, succeeded or failed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_message",
+				Description: "The status message, if the status is failed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "warning_message",
+				Description: "The warning message.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "network_path_found",
+				Description: "Indicates whether the destination is reachable from the source.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "start_date",
+				Description: "The time the analysis started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "forward_path_components",
+				Description: "The components in the path from source to destination.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "return_path_components",
+				Description: "The components in the path from destination to source.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "explanations",
+				Description: "The explanations. For more information, see Reachability Analyzer explanation codes.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "alternate_path_hints",
+				Description: "Potential intermediate components.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "additional_accounts",
+				Description: "The member accounts that contain resources that the path can traverse.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "filter_in_arns",
+				Description: "The Amazon Resource Names (ARN) of the resources that the path must traverse.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "suggested_accounts",
+				Description: "Potential intermediate accounts.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the network insights analysis.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getEc2NetworkInsightsAnalysisTurbotTitle),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getEc2NetworkInsightsAnalysisTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("NetworkInsightsAnalysisArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2NetworkInsightsAnalyses(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_network_insights_analysis.listEc2NetworkInsightsAnalyses", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &ec2.DescribeNetworkInsightsAnalysesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	if d.EqualsQualString("network_insights_path_id") != "" {
+		input.NetworkInsightsPathId = aws.String(d.EqualsQualString("network_insights_path_id"))
+	}
+	if d.EqualsQualString("status") != "" {
+		input.Filters = []types.Filter{
+			{
+				Name:   aws.String("status"),
+				Values: []string{d.EqualsQualString("status")},
+			},
+		}
+	}
+
+	paginator := ec2.NewDescribeNetworkInsightsAnalysesPaginator(svc, input, func(o *ec2.DescribeNetworkInsightsAnalysesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_network_insights_analysis.listEc2NetworkInsightsAnalyses", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.NetworkInsightsAnalyses {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2NetworkInsightsAnalysis(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	analysisID := d.EqualsQualString("network_insights_analysis_id")
+
+	// Empty check
+	if analysisID == "" {
+		return nil, nil
+	}
+
+	// create service
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_network_insights_analysis.getEc2NetworkInsightsAnalysis", "connection_error", err)
+		return nil, err
+	}
+
+	params := &ec2.DescribeNetworkInsightsAnalysesInput{
+		NetworkInsightsAnalysisIds: []string{analysisID},
+	}
+
+	op, err := svc.DescribeNetworkInsightsAnalyses(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_network_insights_analysis.getEc2NetworkInsightsAnalysis", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.NetworkInsightsAnalyses) > 0 {
+		return op.NetworkInsightsAnalyses[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getEc2NetworkInsightsAnalysisTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(types.NetworkInsightsAnalysis)
+	if data.Tags == nil {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range data.Tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return &turbotTagsMap, nil
+}
+
+func getEc2NetworkInsightsAnalysisTurbotTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(types.NetworkInsightsAnalysis)
+	title := data.NetworkInsightsAnalysisId
+	if data.Tags != nil {
+		for _, i := range data.Tags {
+			if *i.Key == "Name" {
+				title = i.Value
+			}
+		}
+	}
+	return title, nil
+}
@@ -0,0 +1,286 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	ec2v1 "github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2NetworkInsightsPath(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_network_insights_path",
+		Description: "AWS EC2 Network Insights Path",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("network_insights_path_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidNetworkInsightsPathId.NotFound", "InvalidNetworkInsightsPathId.Malformed", "InvalidParameterValue"}),
+			},
+			Hydrate: getEc2NetworkInsightsPath,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeNetworkInsightsPaths"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2NetworkInsightsPaths,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeNetworkInsightsPaths"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "source", Require: plugin.Optional},
+				{Name: "destination", Require: plugin.Optional},
+				{Name: "protocol", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(ec2v1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "network_insights_path_id",
+				Description: "The ID of the path.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "network_insights_path_arn",
+				Description: "The Amazon Resource Name (ARN) of the path.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_date",
+				Description: "The time stamp when the path was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "source",
+				Description: "The ID of the source.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_arn",
+				Description: "The Amazon Resource Name (ARN) of the source.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_ip",
+				Description: "The IP address of the source.",
+				Type:        proto.ColumnType_IPADDR,
+			},
+			{
+				Name:        "destination",
+				Description: "The ID of the destination.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "destination_arn",
+				Description: "The Amazon Resource Name (ARN) of the destination.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "destination_ip",
+				Description: "The IP address of the destination.",
+				Type:        proto.ColumnType_IPADDR,
+			},
+			{
+				Name:        "destination_port",
+				Description: "The destination port.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "protocol",
+				Description: "The protocol. Can be tcp or udp.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "filter_at_source",
+				Description: "Scopes the analysis to network paths that match specific filters at the source.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "filter_at_destination",
+				Description: "Scopes the analysis to network paths that match specific filters at the destination.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the path.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getEc2NetworkInsightsPathTurbotTitle),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getEc2NetworkInsightsPathTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("NetworkInsightsPathArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2NetworkInsightsPaths(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_network_insights_path.listEc2NetworkInsightsPaths", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &ec2.DescribeNetworkInsightsPathsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	filters := buildEc2NetworkInsightsPathFilter(d.EqualsQuals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	paginator := ec2.NewDescribeNetworkInsightsPathsPaginator(svc, input, func(o *ec2.DescribeNetworkInsightsPathsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_network_insights_path.listEc2NetworkInsightsPaths", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.NetworkInsightsPaths {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2NetworkInsightsPath(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	pathID := d.EqualsQualString("network_insights_path_id")
+
+	// Empty check
+	if pathID == "" {
+		return nil, nil
+	}
+
+	// create service
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_network_insights_path.getEc2NetworkInsightsPath", "connection_error", err)
+		return nil, err
+	}
+
+	params := &ec2.DescribeNetworkInsightsPathsInput{
+		NetworkInsightsPathIds: []string{pathID},
+	}
+
+	op, err := svc.DescribeNetworkInsightsPaths(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_network_insights_path.getEc2NetworkInsightsPath", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.NetworkInsightsPaths) > 0 {
+		return op.NetworkInsightsPaths[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getEc2NetworkInsightsPathTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(types.NetworkInsightsPath)
+	if data.Tags == nil {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range data.Tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return &turbotTagsMap, nil
+}
+
+func getEc2NetworkInsightsPathTurbotTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(types.NetworkInsightsPath)
+	title := data.NetworkInsightsPathId
+	if data.Tags != nil {
+		for _, i := range data.Tags {
+			if *i.Key == "Name" {
+				title = i.Value
+			}
+		}
+	}
+	return title, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// Build EC2 network insights path list call input filter
+func buildEc2NetworkInsightsPathFilter(equalQuals plugin.KeyColumnEqualsQualMap) []types.Filter {
+	filters := make([]types.Filter, 0)
+
+	filterQuals := map[string]string{
+		"source":      "source",
+		"destination": "destination",
+		"protocol":    "protocol",
+	}
+
+	for columnName, filterName := range filterQuals {
+		if equalQuals[columnName] != nil {
+			filter := types.Filter{
+				Name: aws.String(filterName),
+			}
+			value := equalQuals[columnName]
+			if value.GetStringValue() != "" {
+				filter.Values = []string{equalQuals[columnName].GetStringValue()}
+			}
+			filters = append(filters, filter)
+		}
+	}
+	return filters
+}
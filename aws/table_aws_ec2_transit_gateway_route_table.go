@@ -42,6 +42,12 @@ func tableAwsEc2TransitGatewayRouteTable(_ context.Context) *plugin.Table {
 				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidAction"}),
 			},
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getEc2TransitGatewayRouteTableRoutes,
+				Tags: map[string]string{"service": "ec2", "action": "SearchTransitGatewayRoutes"},
+			},
+		},
 		GetMatrixItemFunc: SupportedRegionMatrix(ec2v1.EndpointsID),
 		Columns: awsRegionalColumns([]*plugin.Column{
 			{
@@ -74,6 +80,13 @@ func tableAwsEc2TransitGatewayRouteTable(_ context.Context) *plugin.Table {
 				Description: "Indicates whether this is the default propagation route table for the transit gateway.",
 				Type:        proto.ColumnType_BOOL,
 			},
+			{
+				Name:        "routes",
+				Description: "The active, blackhole and pending routes of the transit gateway route table. At most 1000 routes are returned.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2TransitGatewayRouteTableRoutes,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags assigned.",
@@ -226,6 +239,42 @@ func getAwsEc2TransitGatewayRouteTableTurbotData(ctx context.Context, d *plugin.
 	return akas, nil
 }
 
+func getEc2TransitGatewayRouteTableRoutes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	routeTableID := h.Item.(types.TransitGatewayRouteTable).TransitGatewayRouteTableId
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_route_table.getEc2TransitGatewayRouteTableRoutes", "connection_error", err)
+		return nil, err
+	}
+
+	// The state filter is required by the API; include routes in every state other than deleting and deleted
+	params := &ec2.SearchTransitGatewayRoutesInput{
+		MaxResults:                 aws.Int32(1000),
+		TransitGatewayRouteTableId: routeTableID,
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: []string{"active", "blackhole", "pending"},
+			},
+		},
+	}
+
+	op, err := svc.SearchTransitGatewayRoutes(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_route_table.getEc2TransitGatewayRouteTableRoutes", "api_error", err)
+		return nil, err
+	}
+
+	// SearchTransitGatewayRoutes does not paginate, so any routes beyond MaxResults are not returned
+	if aws.ToBool(op.AdditionalRoutesAvailable) {
+		plugin.Logger(ctx).Warn("aws_ec2_transit_gateway_route_table.getEc2TransitGatewayRouteTableRoutes", "route_table_id", aws.ToString(routeTableID), "message", "more than 1000 routes available, only the first 1000 are returned")
+	}
+
+	return op.Routes, nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func getEc2TransitGatewayRouteTableTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -96,6 +96,11 @@ func tableAwsEc2TransitGatewayVpcAttachment(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Association.TransitGatewayRouteTableId"),
 			},
+			{
+				Name:        "association",
+				Description: "The association of the attachment with a transit gateway route table.",
+				Type:        proto.ColumnType_JSON,
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags assigned.",
@@ -0,0 +1,270 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	elasticachev1 "github.com/aws/aws-sdk-go/service/elasticache"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsElastiCacheServerlessCache(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_elasticache_serverless_cache",
+		Description: "AWS ElastiCache Serverless Cache",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("serverless_cache_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ServerlessCacheNotFoundFault", "InvalidParameterValue"}),
+			},
+			Hydrate: getElastiCacheServerlessCache,
+			Tags:    map[string]string{"service": "elasticache", "action": "DescribeServerlessCaches"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listElastiCacheServerlessCaches,
+			Tags:    map[string]string{"service": "elasticache", "action": "DescribeServerlessCaches"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: listTagsForElastiCacheServerlessCache,
+				Tags: map[string]string{"service": "elasticache", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(elasticachev1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "serverless_cache_name",
+				Description: "The unique identifier of the serverless cache.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the serverless cache.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ARN"),
+			},
+			{
+				Name:        "description",
+				Description: "A description of the serverless cache.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the serverless cache. The allowed values are CREATING, AVAILABLE, DELETING, CREATE-FAILED and MODIFYING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "When the serverless cache was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "engine",
+				Description: "The engine the serverless cache is compatible with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "major_engine_version",
+				Description: "The version number of the engine the serverless cache is compatible with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "full_engine_version",
+				Description: "The name and version number of the engine the serverless cache is compatible with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The ID of the Amazon Web Services Key Management Service (KMS) key that is used to encrypt data at rest in the serverless cache.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_group_id",
+				Description: "The identifier of the user group associated with the serverless cache. Available for Redis only.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "daily_snapshot_time",
+				Description: "The daily time when a cache snapshot will be created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "snapshot_retention_limit",
+				Description: "The current setting for the number of serverless cache snapshots the system will retain.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "cache_usage_limits",
+				Description: "The cache usage limit for the serverless cache.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "endpoint",
+				Description: "Represents the information required for client programs to connect to a cache node.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "reader_endpoint",
+				Description: "Represents the information required for client programs to connect to a cache node.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "security_group_ids",
+				Description: "The IDs of the EC2 security groups associated with the serverless cache.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "subnet_ids",
+				Description: "The IDs of the subnets in which the serverless cache is deployed.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the serverless cache.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listTagsForElastiCacheServerlessCache,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServerlessCacheName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listTagsForElastiCacheServerlessCache,
+				Transform:   transform.From(clusterTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ARN").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listElastiCacheServerlessCaches(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ElastiCacheClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_elasticache_serverless_cache.listElastiCacheServerlessCaches", "connection_error", err)
+		return nil, err
+	}
+
+	input := &elasticache.DescribeServerlessCachesInput{
+		MaxResults: aws.Int32(100),
+	}
+
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < *input.MaxResults {
+			if limit < 1 {
+				input.MaxResults = aws.Int32(1)
+			} else {
+				input.MaxResults = aws.Int32(limit)
+			}
+		}
+	}
+
+	paginator := elasticache.NewDescribeServerlessCachesPaginator(svc, input, func(o *elasticache.DescribeServerlessCachesPaginatorOptions) {
+		o.Limit = *input.MaxResults
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_elasticache_serverless_cache.listElastiCacheServerlessCaches", "api_error", err)
+			return nil, err
+		}
+
+		for _, serverlessCache := range output.ServerlessCaches {
+			d.StreamListItem(ctx, serverlessCache)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getElastiCacheServerlessCache(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	svc, err := ElastiCacheClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_elasticache_serverless_cache.getElastiCacheServerlessCache", "connection_error", err)
+		return nil, err
+	}
+
+	name := d.EqualsQualString("serverless_cache_name")
+
+	// Return nil, if no input provided
+	if name == "" {
+		return nil, nil
+	}
+
+	params := &elasticache.DescribeServerlessCachesInput{
+		ServerlessCacheName: aws.String(name),
+	}
+
+	op, err := svc.DescribeServerlessCaches(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_elasticache_serverless_cache.getElastiCacheServerlessCache", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.ServerlessCaches) > 0 {
+		return op.ServerlessCaches[0], nil
+	}
+
+	return nil, nil
+}
+
+func listTagsForElastiCacheServerlessCache(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	serverlessCache := h.Item.(types.ServerlessCache)
+
+	// Create session
+	svc, err := ElastiCacheClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_elasticache_serverless_cache.listTagsForElastiCacheServerlessCache", "connection_error", err)
+		return nil, err
+	}
+
+	// Build param
+	param := &elasticache.ListTagsForResourceInput{
+		ResourceName: serverlessCache.ARN,
+	}
+
+	serverlessCacheTags, err := svc.ListTagsForResource(ctx, param)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_elasticache_serverless_cache.listTagsForElastiCacheServerlessCache", "api_error", err)
+		return nil, err
+	}
+
+	return serverlessCacheTags, nil
+}
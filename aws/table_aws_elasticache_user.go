@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+
+	elasticachev1 "github.com/aws/aws-sdk-go/service/elasticache"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsElastiCacheUser(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_elasticache_user",
+		Description: "AWS ElastiCache User",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("user_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"UserNotFound", "InvalidParameterValue"}),
+			},
+			Hydrate: getElastiCacheUser,
+			Tags:    map[string]string{"service": "elasticache", "action": "DescribeUsers"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listElastiCacheUsers,
+			Tags:    map[string]string{"service": "elasticache", "action": "DescribeUsers"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "engine", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(elasticachev1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "user_id",
+				Description: "The ID of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_name",
+				Description: "The username of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ARN"),
+			},
+			{
+				Name:        "status",
+				Description: "Indicates the user status. Can be \"active\", \"modifying\" or \"deleting\".",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine",
+				Description: "The current supported value is Redis.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "minimum_engine_version",
+				Description: "The minimum engine version required, which is Redis 6.0.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "access_string",
+				Description: "Access permissions string used for this user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "authentication",
+				Description: "Denotes whether the user requires a password to authenticate, and the number of passwords set. Password values are never returned.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "user_group_ids",
+				Description: "Returns a list of the user group IDs the user belongs to.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ARN").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listElastiCacheUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ElastiCacheClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_elasticache_user.listElastiCacheUsers", "connection_error", err)
+		return nil, err
+	}
+
+	input := &elasticache.DescribeUsersInput{
+		MaxRecords: aws.Int32(100),
+	}
+
+	if d.EqualsQualString("engine") != "" {
+		input.Engine = aws.String(d.EqualsQualString("engine"))
+	}
+
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < *input.MaxRecords {
+			if limit < 20 {
+				input.MaxRecords = aws.Int32(20)
+			} else {
+				input.MaxRecords = aws.Int32(limit)
+			}
+		}
+	}
+
+	paginator := elasticache.NewDescribeUsersPaginator(svc, input, func(o *elasticache.DescribeUsersPaginatorOptions) {
+		o.Limit = *input.MaxRecords
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_elasticache_user.listElastiCacheUsers", "api_error", err)
+			return nil, err
+		}
+
+		for _, user := range output.Users {
+			d.StreamListItem(ctx, user)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getElastiCacheUser(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	svc, err := ElastiCacheClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_elasticache_user.getElastiCacheUser", "connection_error", err)
+		return nil, err
+	}
+
+	userID := d.EqualsQualString("user_id")
+
+	// Return nil, if no input provided
+	if userID == "" {
+		return nil, nil
+	}
+
+	params := &elasticache.DescribeUsersInput{
+		UserId: aws.String(userID),
+	}
+
+	op, err := svc.DescribeUsers(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_elasticache_user.getElastiCacheUser", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.Users) > 0 {
+		return op.Users[0], nil
+	}
+
+	return nil, nil
+}
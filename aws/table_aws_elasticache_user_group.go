@@ -0,0 +1,230 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	elasticachev1 "github.com/aws/aws-sdk-go/service/elasticache"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsElastiCacheUserGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_elasticache_user_group",
+		Description: "AWS ElastiCache User Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("user_group_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"UserGroupNotFound", "InvalidParameterValue"}),
+			},
+			Hydrate: getElastiCacheUserGroup,
+			Tags:    map[string]string{"service": "elasticache", "action": "DescribeUserGroups"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listElastiCacheUserGroups,
+			Tags:    map[string]string{"service": "elasticache", "action": "DescribeUserGroups"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: listTagsForElastiCacheUserGroup,
+				Tags: map[string]string{"service": "elasticache", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(elasticachev1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "user_group_id",
+				Description: "The ID of the user group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the user group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ARN"),
+			},
+			{
+				Name:        "status",
+				Description: "Indicates user group status. Can be \"creating\", \"active\", \"modifying\" or \"deleting\".",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine",
+				Description: "The current supported value is Redis.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "minimum_engine_version",
+				Description: "The minimum engine version required, which is Redis 6.0.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_ids",
+				Description: "The list of user IDs that belong to the user group.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "pending_changes",
+				Description: "A list of updates being applied to the user group.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "replication_groups",
+				Description: "A list of replication groups that the user group can access.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "serverless_caches",
+				Description: "Indicates which serverless caches the specified user group is associated with.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the user group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listTagsForElastiCacheUserGroup,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserGroupId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listTagsForElastiCacheUserGroup,
+				Transform:   transform.From(clusterTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ARN").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listElastiCacheUserGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ElastiCacheClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_elasticache_user_group.listElastiCacheUserGroups", "connection_error", err)
+		return nil, err
+	}
+
+	input := &elasticache.DescribeUserGroupsInput{
+		MaxRecords: aws.Int32(100),
+	}
+
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < *input.MaxRecords {
+			if limit < 20 {
+				input.MaxRecords = aws.Int32(20)
+			} else {
+				input.MaxRecords = aws.Int32(limit)
+			}
+		}
+	}
+
+	paginator := elasticache.NewDescribeUserGroupsPaginator(svc, input, func(o *elasticache.DescribeUserGroupsPaginatorOptions) {
+		o.Limit = *input.MaxRecords
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_elasticache_user_group.listElastiCacheUserGroups", "api_error", err)
+			return nil, err
+		}
+
+		for _, userGroup := range output.UserGroups {
+			d.StreamListItem(ctx, userGroup)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getElastiCacheUserGroup(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	svc, err := ElastiCacheClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_elasticache_user_group.getElastiCacheUserGroup", "connection_error", err)
+		return nil, err
+	}
+
+	userGroupID := d.EqualsQualString("user_group_id")
+
+	// Return nil, if no input provided
+	if userGroupID == "" {
+		return nil, nil
+	}
+
+	params := &elasticache.DescribeUserGroupsInput{
+		UserGroupId: aws.String(userGroupID),
+	}
+
+	op, err := svc.DescribeUserGroups(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_elasticache_user_group.getElastiCacheUserGroup", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.UserGroups) > 0 {
+		return op.UserGroups[0], nil
+	}
+
+	return nil, nil
+}
+
+func listTagsForElastiCacheUserGroup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	userGroup := h.Item.(types.UserGroup)
+
+	// Create session
+	svc, err := ElastiCacheClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_elasticache_user_group.listTagsForElastiCacheUserGroup", "connection_error", err)
+		return nil, err
+	}
+
+	// Build param
+	param := &elasticache.ListTagsForResourceInput{
+		ResourceName: userGroup.ARN,
+	}
+
+	userGroupTags, err := svc.ListTagsForResource(ctx, param)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_elasticache_user_group.listTagsForElastiCacheUserGroup", "api_error", err)
+		return nil, err
+	}
+
+	return userGroupTags, nil
+}
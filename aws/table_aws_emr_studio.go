@@ -0,0 +1,264 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+	"github.com/aws/aws-sdk-go-v2/service/emr/types"
+
+	emrv1 "github.com/aws/aws-sdk-go/service/emr"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEmrStudio(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_emr_studio",
+		Description: "AWS EMR Studio",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("studio_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidRequestException"}),
+			},
+			Hydrate: getEmrStudio,
+			Tags:    map[string]string{"service": "elasticmapreduce", "action": "DescribeStudio"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEmrStudios,
+			Tags:    map[string]string{"service": "elasticmapreduce", "action": "ListStudios"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getEmrStudio,
+				Tags: map[string]string{"service": "elasticmapreduce", "action": "DescribeStudio"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(emrv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "studio_id",
+				Description: "The ID of the Amazon EMR Studio.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the Amazon EMR Studio.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the Amazon EMR Studio.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getEmrStudio,
+				Transform:   transform.FromField("StudioArn"),
+			},
+			{
+				Name:        "description",
+				Description: "The detailed description of the Amazon EMR Studio.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "auth_mode",
+				Description: "Specifies whether the Studio authenticates users using IAM or IAM Identity Center.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time when the Amazon EMR Studio was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "url",
+				Description: "The unique access URL of the Amazon EMR Studio.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vpc_id",
+				Description: "The ID of the VPC associated with the Amazon EMR Studio.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subnet_ids",
+				Description: "The list of IDs of the subnets associated with the Amazon EMR Studio.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEmrStudio,
+			},
+			{
+				Name:        "service_role",
+				Description: "The name of the IAM role assumed by the Amazon EMR Studio.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getEmrStudio,
+			},
+			{
+				Name:        "user_role",
+				Description: "The name of the IAM role assumed by users logged in to the Amazon EMR Studio.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getEmrStudio,
+			},
+			{
+				Name:        "workspace_security_group_id",
+				Description: "The ID of the Workspace security group associated with the Amazon EMR Studio.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getEmrStudio,
+			},
+			{
+				Name:        "engine_security_group_id",
+				Description: "The ID of the Engine security group associated with the Amazon EMR Studio.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getEmrStudio,
+			},
+			{
+				Name:        "default_s3_location",
+				Description: "The Amazon S3 location to back up Amazon EMR Studio Workspaces and notebook files.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getEmrStudio,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the Amazon EMR Studio.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEmrStudio,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEmrStudio,
+				Transform:   transform.FromField("Tags").Transform(getEmrStudioTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEmrStudio,
+				Transform:   transform.FromField("StudioArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEmrStudios(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := EMRClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_emr_studio.listEmrStudios", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &emr.ListStudiosInput{}
+
+	paginator := emr.NewListStudiosPaginator(svc, input, func(o *emr.ListStudiosPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_emr_studio.listEmrStudios", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Studios {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEmrStudio(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		id = emrStudioID(h.Item)
+	} else {
+		id = d.EqualsQualString("studio_id")
+	}
+
+	// Return nil, if no input provided
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EMRClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_emr_studio.getEmrStudio", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &emr.DescribeStudioInput{
+		StudioId: aws.String(id),
+	}
+
+	op, err := svc.DescribeStudio(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_emr_studio.getEmrStudio", "api_error", err)
+		return nil, err
+	}
+
+	return op.Studio, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getEmrStudioTurbotTags(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	studio, ok := d.HydrateItem.(*types.Studio)
+	if !ok || studio == nil {
+		return nil, nil
+	}
+
+	// Mapping the resource tags inside turbotTags
+	var turbotTagsMap map[string]string
+	if studio.Tags != nil {
+		turbotTagsMap = map[string]string{}
+		for _, i := range studio.Tags {
+			turbotTagsMap[*i.Key] = *i.Value
+		}
+	}
+
+	return turbotTagsMap, nil
+}
+
+func emrStudioID(item interface{}) string {
+	switch item := item.(type) {
+	case types.StudioSummary:
+		return *item.StudioId
+	case *types.Studio:
+		return *item.StudioId
+	}
+	return ""
+}
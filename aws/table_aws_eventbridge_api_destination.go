@@ -0,0 +1,221 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	eventbridgev1 "github.com/aws/aws-sdk-go/service/eventbridge"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsEventBridgeApiDestination(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_eventbridge_api_destination",
+		Description: "AWS EventBridge API Destination",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getAwsEventBridgeApiDestination,
+			Tags:    map[string]string{"service": "events", "action": "DescribeApiDestination"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAwsEventBridgeApiDestinations,
+			Tags:    map[string]string{"service": "events", "action": "ListApiDestinations"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "connection_arn", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getAwsEventBridgeApiDestination,
+				Tags: map[string]string{"service": "events", "action": "DescribeApiDestination"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(eventbridgev1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the API destination.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the API destination.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ApiDestinationArn"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the API destination.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsEventBridgeApiDestination,
+			},
+			{
+				Name:        "api_destination_state",
+				Description: "The state of the API destination. Can be ACTIVE or INACTIVE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "connection_arn",
+				Description: "The ARN of the connection specified for the API destination.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "invocation_endpoint",
+				Description: "The URL to the endpoint for the API destination.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "http_method",
+				Description: "The method to use for the request to the HTTP invocation endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "invocation_rate_limit_per_second",
+				Description: "The maximum number of invocations per second to send to the HTTP invocation endpoint.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "creation_time",
+				Description: "A time stamp for the time that the API destination was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "A time stamp for the time that the API destination was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ApiDestinationArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsEventBridgeApiDestinations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get client
+	svc, err := EventBridgeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_api_destination.listAwsEventBridgeApiDestinations", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	pagesLeft := true
+	params := &eventbridge.ListApiDestinationsInput{
+		// Default to the maximum allowed
+		Limit: aws.Int32(maxLimit),
+	}
+
+	// Additonal Filter
+	if d.EqualsQualString("connection_arn") != "" {
+		params.ConnectionArn = aws.String(d.EqualsQualString("connection_arn"))
+	}
+
+	// API doesn't support aws-go-sdk-v2 paginator as of date
+	for pagesLeft {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.ListApiDestinations(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_eventbridge_api_destination.listAwsEventBridgeApiDestinations", "api_error", err)
+			return nil, err
+		}
+
+		for _, apiDestination := range output.ApiDestinations {
+			d.StreamListItem(ctx, apiDestination)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken != nil {
+			pagesLeft = true
+			params.NextToken = output.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsEventBridgeApiDestination(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name string
+	if h.Item != nil {
+		name = *h.Item.(types.ApiDestination).Name
+	} else {
+		name = d.EqualsQuals["name"].GetStringValue()
+	}
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EventBridgeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_api_destination.getAwsEventBridgeApiDestination", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build the params
+	params := &eventbridge.DescribeApiDestinationInput{
+		Name: aws.String(name),
+	}
+
+	// Get call
+	data, err := svc.DescribeApiDestination(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_api_destination.getAwsEventBridgeApiDestination", "api_error", err)
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,281 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	eventbridgev1 "github.com/aws/aws-sdk-go/service/eventbridge"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsEventBridgeConnection(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_eventbridge_connection",
+		Description: "AWS EventBridge Connection",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getAwsEventBridgeConnection,
+			Tags:    map[string]string{"service": "events", "action": "DescribeConnection"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAwsEventBridgeConnections,
+			Tags:    map[string]string{"service": "events", "action": "ListConnections"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "connection_state", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getAwsEventBridgeConnection,
+				Tags: map[string]string{"service": "events", "action": "DescribeConnection"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(eventbridgev1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectionArn"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsEventBridgeConnection,
+			},
+			{
+				Name:        "connection_state",
+				Description: "The state of the connection. Can be CREATING, UPDATING, DELETING, AUTHORIZED, DEAUTHORIZED, AUTHORIZING or DEAUTHORIZING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state_reason",
+				Description: "The reason that the connection is in the current connection state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "authorization_type",
+				Description: "The type of authorization specified for the connection. Can be BASIC, OAUTH_CLIENT_CREDENTIALS or API_KEY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "secret_arn",
+				Description: "The ARN of the secret created from the authorization parameters specified for the connection.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsEventBridgeConnection,
+			},
+			{
+				Name:        "creation_time",
+				Description: "A time stamp for the time that the connection was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_authorized_time",
+				Description: "A time stamp for the time that the connection was last authorized.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "A time stamp for the time that the connection was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "auth_parameters",
+				Description: "The parameters used for authorization. The values of parameters marked as secret are redacted.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsEventBridgeConnection,
+				Transform:   transform.FromField("AuthParameters").Transform(eventBridgeConnectionRedactAuthParameters),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConnectionArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsEventBridgeConnections(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get client
+	svc, err := EventBridgeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_connection.listAwsEventBridgeConnections", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	pagesLeft := true
+	params := &eventbridge.ListConnectionsInput{
+		// Default to the maximum allowed
+		Limit: aws.Int32(maxLimit),
+	}
+
+	// Additonal Filter
+	if d.EqualsQualString("connection_state") != "" {
+		params.ConnectionState = types.ConnectionState(d.EqualsQualString("connection_state"))
+	}
+
+	// API doesn't support aws-go-sdk-v2 paginator as of date
+	for pagesLeft {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.ListConnections(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_eventbridge_connection.listAwsEventBridgeConnections", "api_error", err)
+			return nil, err
+		}
+
+		for _, connection := range output.Connections {
+			d.StreamListItem(ctx, connection)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken != nil {
+			pagesLeft = true
+			params.NextToken = output.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsEventBridgeConnection(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name string
+	if h.Item != nil {
+		name = *h.Item.(types.Connection).Name
+	} else {
+		name = d.EqualsQuals["name"].GetStringValue()
+	}
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EventBridgeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_connection.getAwsEventBridgeConnection", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build the params
+	params := &eventbridge.DescribeConnectionInput{
+		Name: aws.String(name),
+	}
+
+	// Get call
+	data, err := svc.DescribeConnection(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_connection.getAwsEventBridgeConnection", "api_error", err)
+		return nil, err
+	}
+
+	return data, nil
+}
+
+//// TRANSFORM FUNCTION
+
+// The credentials of a connection are stored in Secrets Manager and are never
+// returned, but the additional HTTP parameters may include values marked as
+// secret which must not be surfaced.
+func eventBridgeConnectionRedactAuthParameters(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	authParameters, ok := d.Value.(*types.ConnectionAuthResponseParameters)
+	if !ok || authParameters == nil {
+		return nil, nil
+	}
+
+	redacted := *authParameters
+	redacted.InvocationHttpParameters = redactConnectionHttpParameters(authParameters.InvocationHttpParameters)
+	if authParameters.OAuthParameters != nil {
+		oAuthParameters := *authParameters.OAuthParameters
+		oAuthParameters.OAuthHttpParameters = redactConnectionHttpParameters(authParameters.OAuthParameters.OAuthHttpParameters)
+		redacted.OAuthParameters = &oAuthParameters
+	}
+
+	return redacted, nil
+}
+
+//// UTILITY FUNCTION
+
+func redactConnectionHttpParameters(httpParameters *types.ConnectionHttpParameters) *types.ConnectionHttpParameters {
+	if httpParameters == nil {
+		return nil
+	}
+
+	redacted := &types.ConnectionHttpParameters{}
+	for _, p := range httpParameters.BodyParameters {
+		if p.IsValueSecret {
+			p.Value = nil
+		}
+		redacted.BodyParameters = append(redacted.BodyParameters, p)
+	}
+	for _, p := range httpParameters.HeaderParameters {
+		if p.IsValueSecret {
+			p.Value = nil
+		}
+		redacted.HeaderParameters = append(redacted.HeaderParameters, p)
+	}
+	for _, p := range httpParameters.QueryStringParameters {
+		if p.IsValueSecret {
+			p.Value = nil
+		}
+		redacted.QueryStringParameters = append(redacted.QueryStringParameters, p)
+	}
+
+	return redacted
+}
@@ -0,0 +1,275 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/aws/aws-sdk-go-v2/service/fsx/types"
+
+	fsxv1 "github.com/aws/aws-sdk-go/service/fsx"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsFsxBackup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_fsx_backup",
+		Description: "AWS FSx Backup",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("backup_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"BackupNotFound", "ValidationException"}),
+			},
+			Hydrate: getFsxBackup,
+			Tags:    map[string]string{"service": "fsx", "action": "DescribeBackups"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listFsxBackups,
+			Tags:    map[string]string{"service": "fsx", "action": "DescribeBackups"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "file_system_id", Require: plugin.Optional},
+				{Name: "type", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(fsxv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "backup_id",
+				Description: "The ID of the backup.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the backup resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceARN"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the file-system backup. Possible values are AUTOMATIC, USER_INITIATED and AWS_BACKUP.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle",
+				Description: "The lifecycle status of the backup.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time when a particular backup was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "file_system_id",
+				Description: "The ID of the file system that the backup was taken from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FileSystem.FileSystemId"),
+			},
+			{
+				Name:        "volume_id",
+				Description: "The ID of the volume that the backup was taken from, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Volume.VolumeId"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "Specifies the resource type that's backed up.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The ID of the Key Management Service (KMS) key used to encrypt the backup of the file system's data at rest.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The AWS account ID that owns the backup.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "progress_percent",
+				Description: "The current percent of progress of an asynchronous task.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "source_backup_id",
+				Description: "The ID of the source backup, if the backup was copied from another backup.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_backup_region",
+				Description: "The source Region of the backup, if the backup was copied from another Region.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "directory_information",
+				Description: "The configuration of the self-managed Microsoft Active Directory directory to which the Windows File Server instance is joined.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "failure_details",
+				Description: "Details explaining any failures that occurred when creating a backup.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the backup.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BackupId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(fsxBackupTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResourceARN").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listFsxBackups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := FSxClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_fsx_backup.listFsxBackups", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// https://docs.aws.amazon.com/fsx/latest/APIReference/API_DescribeBackups.html
+	maxItems := int32(1000)
+	input := fsx.DescribeBackupsInput{}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	// Build the filters from the optional key columns
+	if d.EqualsQualString("file_system_id") != "" {
+		input.Filters = append(input.Filters, types.Filter{
+			Name:   types.FilterNameFileSystemId,
+			Values: []string{d.EqualsQualString("file_system_id")},
+		})
+	}
+	if d.EqualsQualString("type") != "" {
+		input.Filters = append(input.Filters, types.Filter{
+			Name:   types.FilterNameBackupType,
+			Values: []string{d.EqualsQualString("type")},
+		})
+	}
+
+	input.MaxResults = aws.Int32(maxItems)
+	paginator := fsx.NewDescribeBackupsPaginator(svc, &input, func(o *fsx.DescribeBackupsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_fsx_backup.listFsxBackups", "api_error", err)
+			return nil, err
+		}
+
+		for _, backup := range output.Backups {
+			d.StreamListItem(ctx, backup)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getFsxBackup(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	backupID := d.EqualsQualString("backup_id")
+
+	// Empty param check
+	if backupID == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := FSxClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_fsx_backup.getFsxBackup", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &fsx.DescribeBackupsInput{
+		BackupIds: []string{backupID},
+	}
+
+	op, err := svc.DescribeBackups(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_fsx_backup.getFsxBackup", "api_error", err)
+		return nil, err
+	}
+
+	if op != nil && len(op.Backups) > 0 {
+		return op.Backups[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func fsxBackupTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	backup := d.HydrateItem.(types.Backup)
+	if backup.Tags == nil {
+		return nil, nil
+	}
+
+	// Get the resource tags
+	turbotTagsMap := map[string]string{}
+	for _, i := range backup.Tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,345 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	gluev1 "github.com/aws/aws-sdk-go/service/glue"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsGlueMLTransform(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_glue_ml_transform",
+		Description: "AWS Glue ML Transform",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("transform_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"EntityNotFoundException"}),
+			},
+			Hydrate: getGlueMLTransform,
+			Tags:    map[string]string{"service": "glue", "action": "GetMLTransform"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listGlueMLTransforms,
+			Tags:    map[string]string{"service": "glue", "action": "GetMLTransforms"},
+		},
+		DefaultIgnoreConfig: &plugin.IgnoreConfig{
+			ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"EntityNotFoundException"}),
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(gluev1.EndpointsID),
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getGlueMLTransformTags,
+				Tags: map[string]string{"service": "glue", "action": "GetTags"},
+			},
+		},
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A user-defined name for the machine learning transform.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "transform_id",
+				Description: "The unique transform ID that is generated for the machine learning transform.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the machine learning transform.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGlueMLTransformArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "description",
+				Description: "A user-defined, long-form description text for the machine learning transform.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the machine learning transform. Can be NOT_READY, READY or DELETING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "transform_type",
+				Description: "The type of machine learning transform.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parameters.TransformType"),
+			},
+			{
+				Name:        "created_on",
+				Description: "A timestamp. The time and date that this machine learning transform was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_on",
+				Description: "A timestamp. The last point in time when this machine learning transform was modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "label_count",
+				Description: "A count identifier for the labeling files generated by Glue for this transform.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "role",
+				Description: "The name or Amazon Resource Name (ARN) of the IAM role with the required permissions.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "glue_version",
+				Description: "This value determines which version of Glue this machine learning transform is compatible with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "max_capacity",
+				Description: "The number of Glue data processing units (DPUs) that are allocated to task runs for this transform.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "max_retries",
+				Description: "The maximum number of times to retry after an MLTaskRun of the machine learning transform fails.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "number_of_workers",
+				Description: "The number of workers of a defined workerType that are allocated when a task of the transform runs.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "timeout",
+				Description: "The timeout in minutes of the machine learning transform.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "worker_type",
+				Description: "The type of predefined worker that is allocated when a task of this transform runs. Can be Standard, G.1X or G.2X.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "evaluation_metrics",
+				Description: "An EvaluationMetrics object. Evaluation metrics provide an estimate of the quality of your machine learning transform.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "input_record_tables",
+				Description: "A list of Glue table definitions used by the transform.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "parameters",
+				Description: "A TransformParameters object. You can use parameters to tune (customize) the behavior of the machine learning transform.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "schema",
+				Description: "A map of key-value pairs representing the columns and data types that this transform can run against.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "transform_encryption",
+				Description: "The encryption-at-rest settings of the transform that apply to accessing user data.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGlueMLTransformTags,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGlueMLTransformArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGlueMLTransforms(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := GlueClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_ml_transform.listGlueMLTransforms", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(maxLimit) {
+			if *limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = int32(*limit)
+			}
+		}
+	}
+	input := &glue.GetMLTransformsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	// List call
+	paginator := glue.NewGetMLTransformsPaginator(svc, input, func(o *glue.GetMLTransformsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_glue_ml_transform.listGlueMLTransforms", "api_error", err)
+			return nil, err
+		}
+		for _, mlTransform := range output.Transforms {
+			d.StreamListItem(ctx, mlTransform)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGlueMLTransform(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQuals["transform_id"].GetStringValue()
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := GlueClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_ml_transform.getGlueMLTransform", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Build the params
+	params := &glue.GetMLTransformInput{
+		TransformId: aws.String(id),
+	}
+
+	// Get call
+	data, err := svc.GetMLTransform(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_ml_transform.getGlueMLTransform", "api_error", err)
+		return nil, err
+	}
+
+	return types.MLTransform{
+		CreatedOn:           data.CreatedOn,
+		Description:         data.Description,
+		EvaluationMetrics:   data.EvaluationMetrics,
+		GlueVersion:         data.GlueVersion,
+		InputRecordTables:   data.InputRecordTables,
+		LabelCount:          data.LabelCount,
+		LastModifiedOn:      data.LastModifiedOn,
+		MaxCapacity:         data.MaxCapacity,
+		MaxRetries:          data.MaxRetries,
+		Name:                data.Name,
+		NumberOfWorkers:     data.NumberOfWorkers,
+		Parameters:          data.Parameters,
+		Role:                data.Role,
+		Schema:              data.Schema,
+		Status:              data.Status,
+		Timeout:             data.Timeout,
+		TransformEncryption: data.TransformEncryption,
+		TransformId:         data.TransformId,
+		WorkerType:          data.WorkerType,
+	}, nil
+}
+
+func getGlueMLTransformTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn, err := getGlueMLTransformArn(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create Session
+	svc, err := GlueClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_ml_transform.getGlueMLTransformTags", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Build the params
+	params := &glue.GetTagsInput{
+		ResourceArn: aws.String(arn.(string)),
+	}
+
+	// Get call
+	data, err := svc.GetTags(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_ml_transform.getGlueMLTransformTags", "api_error", err)
+		return nil, err
+	}
+	return data.Tags, nil
+}
+
+func getGlueMLTransformArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+	data := h.Item.(types.MLTransform)
+
+	// Get common columns
+	c, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_ml_transform.getGlueMLTransformArn", "common_error", err)
+		return nil, err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+
+	// arn format - https://docs.aws.amazon.com/glue/latest/dg/glue-specifying-resource-arns.html
+	// arn:aws:glue:region:account-id:mlTransform/transform-id
+	arn := "arn:" + commonColumnData.Partition + ":glue:" + region + ":" + commonColumnData.AccountId + ":mlTransform/" + *data.TransformId
+
+	return arn, nil
+}
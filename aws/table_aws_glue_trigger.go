@@ -0,0 +1,310 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	gluev1 "github.com/aws/aws-sdk-go/service/glue"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsGlueTrigger(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_glue_trigger",
+		Description: "AWS Glue Trigger",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"EntityNotFoundException"}),
+			},
+			Hydrate: getGlueTrigger,
+			Tags:    map[string]string{"service": "glue", "action": "GetTrigger"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listGlueTriggers,
+			Tags:    map[string]string{"service": "glue", "action": "GetTriggers"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "dependent_job_name", Require: plugin.Optional},
+				{Name: "workflow_name", Require: plugin.Optional},
+			},
+		},
+		DefaultIgnoreConfig: &plugin.IgnoreConfig{
+			ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"EntityNotFoundException"}),
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(gluev1.EndpointsID),
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getGlueTriggerTags,
+				Tags: map[string]string{"service": "glue", "action": "GetTags"},
+			},
+		},
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the trigger.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the trigger.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGlueTriggerArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "id",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of trigger that this is. Can be SCHEDULED, CONDITIONAL, ON_DEMAND or EVENT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the trigger.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A description of this trigger.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "schedule",
+				Description: "A cron expression used to specify the schedule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "workflow_name",
+				Description: "The name of the workflow associated with the trigger.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dependent_job_name",
+				Description: "The name of a job started by the trigger, used to filter the triggers that are returned.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("dependent_job_name"),
+			},
+			{
+				Name:        "actions",
+				Description: "The actions initiated by this trigger.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "predicate",
+				Description: "The predicate of this trigger, which defines when it will fire.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "event_batching_condition",
+				Description: "Batch condition that must be met (specified number of events received or batch time window expired) before EventBridge event trigger fires.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGlueTriggerTags,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGlueTriggerArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGlueTriggers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := GlueClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_trigger.listGlueTriggers", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(200)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(maxLimit) {
+			if *limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = int32(*limit)
+			}
+		}
+	}
+	input := &glue.GetTriggersInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	jobName := d.EqualsQualString("dependent_job_name")
+	if jobName != "" {
+		input.DependentJobName = aws.String(jobName)
+	}
+
+	// List call
+	paginator := glue.NewGetTriggersPaginator(svc, input, func(o *glue.GetTriggersPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_glue_trigger.listGlueTriggers", "api_error", err)
+			return nil, err
+		}
+		for _, trigger := range output.Triggers {
+			// GetTriggers returns all triggers if none of them start the dependent job
+			if jobName != "" && !glueTriggerStartsJob(trigger, jobName) {
+				continue
+			}
+
+			// Workflow name is not supported as an input parameter, so filter it here
+			if d.EqualsQualString("workflow_name") != "" && d.EqualsQualString("workflow_name") != aws.ToString(trigger.WorkflowName) {
+				continue
+			}
+
+			d.StreamListItem(ctx, trigger)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGlueTrigger(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQuals["name"].GetStringValue()
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := GlueClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_trigger.getGlueTrigger", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Build the params
+	params := &glue.GetTriggerInput{
+		Name: aws.String(name),
+	}
+
+	// Get call
+	data, err := svc.GetTrigger(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_trigger.getGlueTrigger", "api_error", err)
+		return nil, err
+	}
+	return *data.Trigger, nil
+}
+
+func getGlueTriggerTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn, err := getGlueTriggerArn(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create Session
+	svc, err := GlueClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_trigger.getGlueTriggerTags", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Build the params
+	params := &glue.GetTagsInput{
+		ResourceArn: aws.String(arn.(string)),
+	}
+
+	// Get call
+	data, err := svc.GetTags(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_trigger.getGlueTriggerTags", "api_error", err)
+		return nil, err
+	}
+	return data.Tags, nil
+}
+
+func getGlueTriggerArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+	data := h.Item.(types.Trigger)
+
+	// Get common columns
+	c, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_trigger.getGlueTriggerArn", "common_error", err)
+		return nil, err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+
+	// arn format - https://docs.aws.amazon.com/glue/latest/dg/glue-specifying-resource-arns.html
+	// arn:aws:glue:region:account-id:trigger/trigger-name
+	arn := "arn:" + commonColumnData.Partition + ":glue:" + region + ":" + commonColumnData.AccountId + ":trigger/" + *data.Name
+
+	return arn, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// glueTriggerStartsJob reports whether any of the trigger actions starts the given job
+func glueTriggerStartsJob(trigger types.Trigger, jobName string) bool {
+	for _, action := range trigger.Actions {
+		if aws.ToString(action.JobName) == jobName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,303 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+
+	imagebuilderv1 "github.com/aws/aws-sdk-go/service/imagebuilder"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsImageBuilderComponent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_imagebuilder_component",
+		Description: "AWS EC2 Image Builder Component",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "InvalidParameterException", "InvalidRequestException"}),
+			},
+			Hydrate: getImageBuilderComponent,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "GetComponent"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listImageBuilderComponents,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "ListComponents"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "ownership", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getImageBuilderComponent,
+				Tags: map[string]string{"service": "imagebuilder", "action": "GetComponent"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(imagebuilderv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the component.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the component build version, which ends with the semantic version and build number of the component (for example, .../component/name/1.0.0/1).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "The semantic version of the component.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the component.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The component type specifies whether Image Builder uses the component to build the image or only to test it. Can be BUILD or TEST.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "platform",
+				Description: "The operating system platform of the component.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner",
+				Description: "The owner of the component.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ownership",
+				Description: "The owner filter used to list components. Can be Self, Shared, Amazon or ThirdParty. Components owned by your account are returned if not specified.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("ownership"),
+			},
+			{
+				Name:        "date_created",
+				Description: "The date that the component was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "change_description",
+				Description: "Describes what change has been made in this version of the component, or what makes this version different from other versions of the component.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "data",
+				Description: "Component data contains the YAML document content for the component.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderComponent,
+			},
+			{
+				Name:        "encrypted",
+				Description: "The encryption status of the component.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getImageBuilderComponent,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The KMS key identifier used to encrypt the component.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderComponent,
+			},
+			{
+				Name:        "publisher",
+				Description: "The publisher of the component. Applies to components that are shared through Amazon Web Services Marketplace.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "supported_os_versions",
+				Description: "The operating system (OS) version supported by the component.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "parameters",
+				Description: "Contains parameter details for each of the parameters that the component document defined for the component.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderComponent,
+			},
+			{
+				Name:        "state",
+				Description: "Describes the current status of the component.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listImageBuilderComponents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_component.listImageBuilderComponents", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(25)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &imagebuilder.ListComponentsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	if d.EqualsQualString("ownership") != "" {
+		input.Owner = types.Ownership(d.EqualsQualString("ownership"))
+	}
+
+	paginator := imagebuilder.NewListComponentsPaginator(svc, input, func(o *imagebuilder.ListComponentsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_imagebuilder_component.listImageBuilderComponents", "api_error", err)
+			return nil, err
+		}
+
+		for _, component := range output.ComponentVersionList {
+			// ListComponents returns semantic version ARNs, but GetComponent
+			// requires a build version ARN, so each row is a build version
+			done, err := listImageBuilderComponentBuildVersions(ctx, d, svc, component.Arn)
+			if err != nil {
+				return nil, err
+			}
+			if done {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// Streams the build versions of a component version. Returns true once the
+// query limit has been reached.
+func listImageBuilderComponentBuildVersions(ctx context.Context, d *plugin.QueryData, svc *imagebuilder.Client, componentVersionArn *string) (bool, error) {
+	input := &imagebuilder.ListComponentBuildVersionsInput{
+		ComponentVersionArn: componentVersionArn,
+		MaxResults:          aws.Int32(25),
+	}
+
+	paginator := imagebuilder.NewListComponentBuildVersionsPaginator(svc, input, func(o *imagebuilder.ListComponentBuildVersionsPaginatorOptions) {
+		o.Limit = 25
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_imagebuilder_component.listImageBuilderComponentBuildVersions", "api_error", err)
+			return false, err
+		}
+
+		for _, buildVersion := range output.ComponentSummaryList {
+			d.StreamListItem(ctx, buildVersion)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getImageBuilderComponent(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn string
+	if h.Item != nil {
+		arn = *h.Item.(types.ComponentSummary).Arn
+	} else {
+		arn = d.EqualsQualString("arn")
+	}
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_component.getImageBuilderComponent", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &imagebuilder.GetComponentInput{
+		ComponentBuildVersionArn: aws.String(arn),
+	}
+
+	op, err := svc.GetComponent(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_component.getImageBuilderComponent", "api_error", err)
+		return nil, err
+	}
+
+	if op.Component != nil {
+		return *op.Component, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,250 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+
+	imagebuilderv1 "github.com/aws/aws-sdk-go/service/imagebuilder"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsImageBuilderImagePipeline(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_imagebuilder_image_pipeline",
+		Description: "AWS EC2 Image Builder Image Pipeline",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "InvalidParameterException", "InvalidRequestException"}),
+			},
+			Hydrate: getImageBuilderImagePipeline,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "GetImagePipeline"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listImageBuilderImagePipelines,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "ListImagePipelines"},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(imagebuilderv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the image pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the image pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the image pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "platform",
+				Description: "The platform of the image pipeline. Can be Windows, Linux or macOS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the image pipeline. Can be DISABLED or ENABLED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "date_created",
+				Description: "The date on which this image pipeline was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "date_updated",
+				Description: "The date on which this image pipeline was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "date_last_run",
+				Description: "This is no longer supported, and does not return a value.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "date_next_run",
+				Description: "The next date when the pipeline is scheduled to run.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "image_recipe_arn",
+				Description: "The Amazon Resource Name (ARN) of the image recipe associated with this image pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "container_recipe_arn",
+				Description: "The Amazon Resource Name (ARN) of the container recipe that is used for this pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "infrastructure_configuration_arn",
+				Description: "The Amazon Resource Name (ARN) of the infrastructure configuration associated with this image pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "distribution_configuration_arn",
+				Description: "The Amazon Resource Name (ARN) of the distribution configuration associated with this image pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enhanced_image_metadata_enabled",
+				Description: "Collects additional information about the image being created, including the operating system (OS) version and package list.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "execution_role",
+				Description: "The name or Amazon Resource Name (ARN) for the IAM role you create that grants Image Builder access to perform workflow actions.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image_scanning_configuration",
+				Description: "Contains settings for vulnerability scans.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "image_tests_configuration",
+				Description: "The image tests configuration of the image pipeline.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "schedule",
+				Description: "The schedule of the image pipeline.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "workflows",
+				Description: "Contains the workflows that run for the image pipeline.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listImageBuilderImagePipelines(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_image_pipeline.listImageBuilderImagePipelines", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(25)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &imagebuilder.ListImagePipelinesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := imagebuilder.NewListImagePipelinesPaginator(svc, input, func(o *imagebuilder.ListImagePipelinesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_imagebuilder_image_pipeline.listImageBuilderImagePipelines", "api_error", err)
+			return nil, err
+		}
+
+		for _, pipeline := range output.ImagePipelineList {
+			d.StreamListItem(ctx, pipeline)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getImageBuilderImagePipeline(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.EqualsQualString("arn")
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_image_pipeline.getImageBuilderImagePipeline", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &imagebuilder.GetImagePipelineInput{
+		ImagePipelineArn: aws.String(arn),
+	}
+
+	op, err := svc.GetImagePipeline(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_image_pipeline.getImageBuilderImagePipeline", "api_error", err)
+		return nil, err
+	}
+
+	if op.ImagePipeline != nil {
+		return *op.ImagePipeline, nil
+	}
+	return nil, nil
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/service/keyspaces"
-	// "github.com/aws/aws-sdk-go-v2/service/keyspaces/types"
+	"github.com/aws/aws-sdk-go-v2/service/keyspaces/types"
 	keyspacesv1 "github.com/aws/aws-sdk-go/service/keyspaces"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -30,6 +30,12 @@ func tableAwsKeyspacesKeyspace(ctx context.Context) *plugin.Table {
 			Hydrate: listKeyspacesKeyspaces, // Parent hydrate function
 			Tags:    map[string]string{"service": "keyspaces", "action": "ListKeyspaces"},
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getKeyspacesKeyspaceTags,
+				Tags: map[string]string{"service": "keyspaces", "action": "ListTagsForResource"},
+			},
+		},
 		GetMatrixItemFunc: SupportedRegionMatrix(keyspacesv1.EndpointsID),
 		Columns: awsRegionalColumns([]*plugin.Column{
 			{
@@ -53,6 +59,13 @@ func tableAwsKeyspacesKeyspace(ctx context.Context) *plugin.Table {
 				Description: "If the replication strategy of the keyspace is MULTI_REGION, a list of replication regions is returned.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the keyspace.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKeyspacesKeyspaceTags,
+				Transform:   transform.FromValue(),
+			},
 
 			/// Steampipe standard columns
 			{
@@ -61,6 +74,13 @@ func tableAwsKeyspacesKeyspace(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("KeyspaceName"),
 			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKeyspacesKeyspaceTags,
+				Transform:   transform.From(keyspacesTurbotTags),
+			},
 			{
 				Name:        "akas",
 				Description: resourceInterfaceDescription("akas"),
@@ -163,3 +183,65 @@ func getKeyspacesKeyspace(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 
 	return result, nil
 }
+
+func getKeyspacesKeyspaceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var resourceArn *string
+	switch item := h.Item.(type) {
+	case types.KeyspaceSummary:
+		resourceArn = item.ResourceArn
+	case *keyspaces.GetKeyspaceOutput:
+		resourceArn = item.ResourceArn
+	}
+
+	if resourceArn == nil {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := KeyspacesClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_keyspaces_keyspace.getKeyspacesKeyspaceTags", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region
+		return nil, nil
+	}
+
+	input := &keyspaces.ListTagsForResourceInput{
+		ResourceArn: resourceArn,
+	}
+
+	paginator := keyspaces.NewListTagsForResourcePaginator(svc, input, func(o *keyspaces.ListTagsForResourcePaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	var tags []types.Tag
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_keyspaces_keyspace.getKeyspacesKeyspaceTags", "api_error", err)
+			return nil, err
+		}
+		tags = append(tags, output.Tags...)
+	}
+
+	return tags, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func keyspacesTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.HydrateItem.([]types.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, tag := range tags {
+		turbotTagsMap[*tag.Key] = *tag.Value
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,199 @@
+package aws
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/smithy-go"
+
+	kmsv1 "github.com/aws/aws-sdk-go/service/kms"
+
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsKmsGrant(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_kms_grant",
+		Description: "AWS KMS Grant",
+		List: &plugin.ListConfig{
+			ParentHydrate: listKmsKeys,
+			Hydrate:       listKmsGrants,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Tags: map[string]string{"service": "kms", "action": "ListGrants"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "key_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "key_arn",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(kmsv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "grant_id",
+				Description: "The unique identifier for the grant.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The friendly name that identifies the grant. If a name was provided in the CreateGrant request, that name is returned. Otherwise this value is null.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key_id",
+				Description: "Unique identifier of the key the grant applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key_arn",
+				Description: "ARN of the key the grant applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "grantee_principal",
+				Description: "The identity that gets the permissions in the grant.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "retiring_principal",
+				Description: "The principal that can retire the grant.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "issuing_account",
+				Description: "The Amazon Web Services account under which the grant was issued.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date and time when the grant was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "operations",
+				Description: "The list of operations permitted by the grant.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "constraints",
+				Description: "A list of key-value pairs that must be present in the encryption context of certain subsequent operations that the grant allows.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GrantId"),
+			},
+		}),
+	}
+}
+
+type kmsGrantInfo struct {
+	KeyId  *string
+	KeyArn *string
+	types.GrantListEntry
+}
+
+//// LIST FUNCTION
+
+func listKmsGrants(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var key types.KeyListEntry
+	if h.Item != nil {
+		key = h.Item.(types.KeyListEntry)
+	}
+
+	if d.EqualsQualString("key_id") != "" || d.EqualsQualString("key_arn") != "" {
+		if d.EqualsQualString("key_id") != "" && d.EqualsQualString("key_id") != *key.KeyId {
+			return nil, nil
+		}
+		if d.EqualsQualString("key_arn") != "" && d.EqualsQualString("key_arn") != *key.KeyArn {
+			return nil, nil
+		}
+	}
+
+	// Create Session
+	svc, err := KMSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_kms_grant.listKmsGrants", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+	input := &kms.ListGrantsInput{
+		KeyId: key.KeyArn,
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+	input.Limit = aws.Int32(maxItems)
+	paginator := kms.NewListGrantsPaginator(svc, input, func(o *kms.ListGrantsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			// In the case of parent hydrate the ignore config seems to not work for the child table. So we need to handle it manually.
+			// Steampipe SDK issue ref: https://github.com/turbot/steampipe-plugin-sdk/issues/544
+			ignoreCodes := GetConfig(d.Connection).IgnoreErrorCodes
+			var ae smithy.APIError
+			if errors.As(err, &ae) {
+				if helpers.StringSliceContains(ignoreCodes, ae.ErrorCode()) {
+					return nil, nil
+				}
+			}
+			plugin.Logger(ctx).Error("aws_kms_grant.listKmsGrants", "api_error", err)
+			return nil, err
+		}
+
+		for _, grant := range output.Grants {
+			d.StreamListItem(ctx, &kmsGrantInfo{
+				KeyId:          key.KeyId,
+				KeyArn:         key.KeyArn,
+				GrantListEntry: grant,
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -13,7 +13,6 @@ import (
 
 	"github.com/aws/smithy-go"
 
-	"github.com/turbot/go-kit/helpers"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
@@ -163,6 +162,18 @@ func tableAwsKmsKey(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_BOOL,
 				Hydrate:     getAwsKmsKeyRotationStatus,
 			},
+			{
+				Name:        "rotation_period_in_days",
+				Description: "The number of days between each automatic rotation of the key material.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAwsKmsKeyRotationStatus,
+			},
+			{
+				Name:        "next_rotation_date",
+				Description: "The next date that KMS will automatically rotate the key material.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getAwsKmsKeyRotationStatus,
+			},
 			{
 				Name:        "policy",
 				Description: "A key policy document in JSON format.",
@@ -369,15 +380,20 @@ func getAwsKmsKeyRotationStatus(ctx context.Context, d *plugin.QueryData, h *plu
 
 	keyData, err := svc.GetKeyRotationStatus(ctx, params)
 	if err != nil {
-		// For AWS managed KMS keys GetKeyRotationStatus API generates exceptions
 		var ae smithy.APIError
 		if errors.As(err, &ae) {
-			if helpers.StringSliceContains([]string{"AccessDeniedException", "UnsupportedOperationException"}, ae.ErrorCode()) {
+			// Asymmetric, HMAC, imported and custom key store keys do not support
+			// automatic rotation, so there is no rotation status to report
+			if ae.ErrorCode() == "UnsupportedOperationException" {
+				return nil, nil
+			}
+			// For AWS managed KMS keys GetKeyRotationStatus API generates exceptions
+			if ae.ErrorCode() == "AccessDeniedException" {
 				return &kms.GetKeyRotationStatusOutput{}, nil
 			}
-			return nil, err
 		}
 		plugin.Logger(ctx).Error("aws_kms_key.getAwsKmsKeyRotationStatus", "api_error", err)
+		return nil, err
 	}
 	return keyData, nil
 }
@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+
+	lakeformationv1 "github.com/aws/aws-sdk-go/service/lakeformation"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLakeFormationPermissions(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lakeformation_permissions",
+		Description: "AWS Lake Formation Permissions",
+		List: &plugin.ListConfig{
+			Hydrate: listLakeFormationPermissions,
+			Tags:    map[string]string{"service": "lakeformation", "action": "ListPermissions"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "principal_identifier", Require: plugin.Optional},
+				{Name: "resource_type", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(lakeformationv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "principal_identifier",
+				Description: "An identifier for the Lake Formation principal, such as an IAM user or role ARN.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Principal.DataLakePrincipalIdentifier"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The resource type used to filter the permissions returned. Can be CATALOG, DATABASE, TABLE, DATA_LOCATION, LF_TAG, LF_TAG_POLICY, LF_TAG_POLICY_DATABASE or LF_TAG_POLICY_TABLE.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("resource_type"),
+			},
+			{
+				Name:        "last_updated",
+				Description: "The date and time when the resource was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_by",
+				Description: "The user who updated the record.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "permissions",
+				Description: "The permissions granted on the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "permissions_with_grant_option",
+				Description: "The permissions that the principal can grant to other principals.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "principal",
+				Description: "The Data Lake principal the permissions are granted to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resource",
+				Description: "The resource the permissions are granted on, such as the catalog, a database, a table, a data location or an LF-tag.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "additional_details",
+				Description: "Additional details of the permissions. Currently only returns a RAM resource share ARN.",
+				Type:        proto.ColumnType_JSON,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLakeFormationPermissions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := LakeFormationClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lakeformation_permissions.listLakeFormationPermissions", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &lakeformation.ListPermissionsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	if d.EqualsQualString("principal_identifier") != "" {
+		input.Principal = &types.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.EqualsQualString("principal_identifier")),
+		}
+	}
+	if d.EqualsQualString("resource_type") != "" {
+		input.ResourceType = types.DataLakeResourceType(d.EqualsQualString("resource_type"))
+	}
+
+	paginator := lakeformation.NewListPermissionsPaginator(svc, input, func(o *lakeformation.ListPermissionsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_lakeformation_permissions.listLakeFormationPermissions", "api_error", err)
+			return nil, err
+		}
+
+		for _, permission := range output.PrincipalResourcePermissions {
+			d.StreamListItem(ctx, permission)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
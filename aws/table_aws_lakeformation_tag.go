@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+
+	lakeformationv1 "github.com/aws/aws-sdk-go/service/lakeformation"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLakeFormationTag(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lakeformation_tag",
+		Description: "AWS Lake Formation Tag",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("tag_key"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"EntityNotFoundException"}),
+			},
+			Hydrate: getLakeFormationTag,
+			Tags:    map[string]string{"service": "lakeformation", "action": "GetLFTag"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listLakeFormationTags,
+			Tags:    map[string]string{"service": "lakeformation", "action": "ListLFTags"},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(lakeformationv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "tag_key",
+				Description: "The key-name for the LF-tag.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "catalog_id",
+				Description: "The identifier for the Data Catalog. By default, the account ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tag_values",
+				Description: "A list of possible values an attribute can take.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TagKey"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLakeFormationTags(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := LakeFormationClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lakeformation_tag.listLakeFormationTags", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &lakeformation.ListLFTagsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := lakeformation.NewListLFTagsPaginator(svc, input, func(o *lakeformation.ListLFTagsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_lakeformation_tag.listLakeFormationTags", "api_error", err)
+			return nil, err
+		}
+
+		for _, tag := range output.LFTags {
+			d.StreamListItem(ctx, tag)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLakeFormationTag(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	tagKey := d.EqualsQualString("tag_key")
+
+	// Empty check
+	if tagKey == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := LakeFormationClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lakeformation_tag.getLakeFormationTag", "connection_error", err)
+		return nil, err
+	}
+
+	params := &lakeformation.GetLFTagInput{
+		TagKey: aws.String(tagKey),
+	}
+
+	op, err := svc.GetLFTag(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lakeformation_tag.getLakeFormationTag", "api_error", err)
+		return nil, err
+	}
+
+	return types.LFTagPair{
+		CatalogId: op.CatalogId,
+		TagKey:    op.TagKey,
+		TagValues: op.TagValues,
+	}, nil
+}
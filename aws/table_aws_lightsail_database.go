@@ -0,0 +1,303 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+
+	lightsailv1 "github.com/aws/aws-sdk-go/service/lightsail"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLightsailDatabase(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lightsail_database",
+		Description: "AWS Lightsail Database",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getLightsailDatabase,
+			Tags:       map[string]string{"service": "lightsail", "action": "GetRelationalDatabase"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidResourceName", "DoesNotExist"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listLightsailDatabases,
+			Tags:    map[string]string{"service": "lightsail", "action": "GetRelationalDatabases"},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(lightsailv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The unique name of the database resource in Lightsail.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine",
+				Description: "The database software (for example, MySQL).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_version",
+				Description: "The database engine version (for example, 5.7.23).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "Describes the current state of the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp when the database was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "master_database_name",
+				Description: "The name of the master database created when the Lightsail database resource is created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "master_username",
+				Description: "The master user name of the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "backup_retention_enabled",
+				Description: "A Boolean value indicating whether automated backup retention is enabled for the database.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "publicly_accessible",
+				Description: "A Boolean value indicating whether the database is publicly accessible.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "preferred_backup_window",
+				Description: "The daily time range during which automated backups are created for the database (for example, 16:00-16:30).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "preferred_maintenance_window",
+				Description: "The weekly time range during which system maintenance can occur on the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "latest_restorable_time",
+				Description: "The latest point in time to which the database can be restored.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "blueprint_id",
+				Description: "The blueprint ID for the database. A blueprint describes the major engine version of a database.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RelationalDatabaseBlueprintId"),
+			},
+			{
+				Name:        "bundle_id",
+				Description: "The bundle ID for the database. A bundle describes the performance specifications for your database.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RelationalDatabaseBundleId"),
+			},
+			{
+				Name:        "ca_certificate_identifier",
+				Description: "The certificate associated with the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parameter_apply_status",
+				Description: "The status of parameter updates for the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_zone",
+				Description: "The Availability Zone where the database is located.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location.AvailabilityZone"),
+			},
+			{
+				Name:        "secondary_availability_zone",
+				Description: "The secondary Availability Zone of a high availability database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The Lightsail resource type for the database (for example, RelationalDatabase).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "support_code",
+				Description: "The support code for the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "hardware",
+				Description: "Describes the hardware of the database.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "master_endpoint",
+				Description: "The master endpoint for the database.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "pending_maintenance_actions",
+				Description: "Describes the pending maintenance actions for the database.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "pending_modified_values",
+				Description: "Describes pending database value modifications. The pending master user password is never returned.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PendingModifiedValues").Transform(redactLightsailDatabasePendingPassword),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the database.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(getLightsailDatabaseTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLightsailDatabases(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := LightsailClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lightsail_database.listLightsailDatabases", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &lightsail.GetRelationalDatabasesInput{}
+
+	// List call
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		resp, err := svc.GetRelationalDatabases(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_lightsail_database.listLightsailDatabases", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range resp.RelationalDatabases {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.NextPageToken != nil {
+			input.PageToken = resp.NextPageToken
+		} else {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLightsailDatabase(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := LightsailClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lightsail_database.getLightsailDatabase", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &lightsail.GetRelationalDatabaseInput{
+		RelationalDatabaseName: aws.String(name),
+	}
+
+	detail, err := svc.GetRelationalDatabase(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lightsail_database.getLightsailDatabase", "api_error", err)
+		return nil, err
+	}
+
+	return *detail.RelationalDatabase, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getLightsailDatabaseTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags := d.Value.([]types.Tag)
+	if tags == nil {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return &turbotTagsMap, nil
+}
+
+// The pending master user password is sensitive, so it is never returned.
+func redactLightsailDatabasePendingPassword(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	values, ok := d.Value.(*types.PendingModifiedRelationalDatabaseValues)
+	if !ok || values == nil {
+		return nil, nil
+	}
+
+	redacted := *values
+	redacted.MasterUserPassword = nil
+
+	return redacted, nil
+}
@@ -0,0 +1,254 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	"github.com/aws/aws-sdk-go-v2/service/mq/types"
+
+	mqv1 "github.com/aws/aws-sdk-go/service/mq"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMQConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_mq_configuration",
+		Description: "AWS MQ Configuration",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("configuration_id"),
+			Hydrate:    getMQConfiguration,
+			Tags:       map[string]string{"service": "mq", "action": "DescribeConfiguration"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException", "BadRequestException"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMQConfigurations,
+			Tags:    map[string]string{"service": "mq", "action": "ListConfigurations"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getMQConfigurationRevision,
+				Tags: map[string]string{"service": "mq", "action": "DescribeConfigurationRevision"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(mqv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "configuration_id",
+				Description: "The unique ID that Amazon MQ generates for the configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "arn",
+				Description: "The ARN of the configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created",
+				Description: "The date and time of the configuration revision.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "engine_type",
+				Description: "The type of broker engine.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_version",
+				Description: "The broker engine version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "authentication_strategy",
+				Description: "The authentication strategy associated with the configuration. The default is SIMPLE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "latest_revision",
+				Description: "The latest revision of the configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "data",
+				Description: "The configuration data of the latest revision. For ActiveMQ this is the XML configuration, for RabbitMQ it is Cuttlefish.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getMQConfigurationRevision,
+				Transform:   transform.FromField("Data").Transform(base64DecodedData),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMQConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := MQClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mq_configuration.listMQConfigurations", "service_creation_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 5 {
+				maxLimit = 5
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &mq.ListConfigurationsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.ListConfigurations(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_mq_configuration.listMQConfigurations", "api_error", err)
+			return nil, err
+		}
+
+		for _, configuration := range output.Configurations {
+			d.StreamListItem(ctx, configuration)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMQConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	configurationId := d.EqualsQualString("configuration_id")
+	if configurationId == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := MQClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mq_configuration.getMQConfiguration", "service_creation_error", err)
+		return nil, err
+	}
+
+	// Unsupported region, return no data
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &mq.DescribeConfigurationInput{
+		ConfigurationId: aws.String(configurationId),
+	}
+
+	op, err := svc.DescribeConfiguration(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mq_configuration.getMQConfiguration", "api_error", err)
+		return nil, err
+	}
+
+	return types.Configuration{
+		Arn:                    op.Arn,
+		AuthenticationStrategy: op.AuthenticationStrategy,
+		Created:                op.Created,
+		Description:            op.Description,
+		EngineType:             op.EngineType,
+		EngineVersion:          op.EngineVersion,
+		Id:                     op.Id,
+		LatestRevision:         op.LatestRevision,
+		Name:                   op.Name,
+		Tags:                   op.Tags,
+	}, nil
+}
+
+func getMQConfigurationRevision(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	configuration := h.Item.(types.Configuration)
+	if configuration.LatestRevision == nil || configuration.LatestRevision.Revision == nil {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := MQClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mq_configuration.getMQConfigurationRevision", "service_creation_error", err)
+		return nil, err
+	}
+
+	// Unsupported region, return no data
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &mq.DescribeConfigurationRevisionInput{
+		ConfigurationId:       configuration.Id,
+		ConfigurationRevision: aws.String(fmt.Sprint(*configuration.LatestRevision.Revision)),
+	}
+
+	op, err := svc.DescribeConfigurationRevision(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mq_configuration.getMQConfigurationRevision", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
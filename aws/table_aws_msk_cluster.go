@@ -158,8 +158,12 @@ func listKafkaClusters(clusterType string) func(ctx context.Context, d *plugin.Q
 		}
 
 		input := kafka.ListClustersV2Input{
-			MaxResults:        aws.Int32(maxLimit),
-			ClusterTypeFilter: &clusterType,
+			MaxResults: aws.Int32(maxLimit),
+		}
+
+		// An empty cluster type lists clusters of every type
+		if clusterType != "" {
+			input.ClusterTypeFilter = &clusterType
 		}
 
 		paginator := kafka.NewListClustersV2Paginator(svc, &input, func(o *kafka.ListClustersV2PaginatorOptions) {
@@ -0,0 +1,255 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
+
+	kafkav1 "github.com/aws/aws-sdk-go/service/kafka"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMSKClusterOperation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_msk_cluster_operation",
+		Description: "AWS Managed Streaming for Apache Kafka Cluster Operation",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("operation_arn"),
+			Hydrate:    getKafkaClusterOperationV2,
+			Tags:       map[string]string{"service": "kafka", "action": "DescribeClusterOperationV2"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listKafkaClusters(""),
+			Hydrate:       listKafkaClusterOperations,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "cluster_arn", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "kafka", "action": "ListClusterOperationsV2"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getKafkaClusterOperationV2,
+				Tags: map[string]string{"service": "kafka", "action": "DescribeClusterOperationV2"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(kafkav1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "operation_arn",
+				Description: "The Amazon Resource Name (ARN) of the cluster operation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cluster_arn",
+				Description: "The Amazon Resource Name (ARN) of the cluster the operation was performed on.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cluster_type",
+				Description: "The type of the cluster, either PROVISIONED or SERVERLESS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "operation_type",
+				Description: "The type of the cluster operation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "operation_state",
+				Description: "The state of the cluster operation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The time at which the operation started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_time",
+				Description: "The time at which the operation finished.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "error_info",
+				Description: "Describes the error if the operation fails.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKafkaClusterOperationV2,
+			},
+			{
+				Name:        "source_cluster_info",
+				Description: "Information about the cluster before the operation started. Only set for provisioned clusters.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKafkaClusterOperationV2,
+				Transform:   transform.FromField("Provisioned.SourceClusterInfo"),
+			},
+			{
+				Name:        "target_cluster_info",
+				Description: "Information about the cluster after the operation finished. Only set for provisioned clusters.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKafkaClusterOperationV2,
+				Transform:   transform.FromField("Provisioned.TargetClusterInfo"),
+			},
+			{
+				Name:        "operation_steps",
+				Description: "Steps completed during the operation. Only set for provisioned clusters.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKafkaClusterOperationV2,
+				Transform:   transform.FromField("Provisioned.OperationSteps"),
+			},
+			{
+				Name:        "vpc_connection_info",
+				Description: "Description of the VPC connection for the operation.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKafkaClusterOperationV2,
+				Transform:   transform.From(kafkaClusterOperationVpcConnectionInfo),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OperationArn"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OperationArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listKafkaClusterOperations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cluster := h.Item.(types.Cluster)
+
+	// Limit API call with given cluster ARN
+	if d.EqualsQualString("cluster_arn") != "" && d.EqualsQualString("cluster_arn") != *cluster.ClusterArn {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := KafkaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_msk_cluster_operation.listKafkaClusterOperations", "service_creation_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &kafka.ListClusterOperationsV2Input{
+		ClusterArn: cluster.ClusterArn,
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := kafka.NewListClusterOperationsV2Paginator(svc, input, func(o *kafka.ListClusterOperationsV2PaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_msk_cluster_operation.listKafkaClusterOperations", "api_error", err)
+			return nil, err
+		}
+
+		for _, operation := range output.ClusterOperationInfoList {
+			d.StreamListItem(ctx, operation)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getKafkaClusterOperationV2(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var operationArn string
+	if h.Item != nil {
+		operationArn = *h.Item.(types.ClusterOperationV2Summary).OperationArn
+	} else {
+		operationArn = d.EqualsQualString("operation_arn")
+	}
+
+	if operationArn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := KafkaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_msk_cluster_operation.getKafkaClusterOperationV2", "service_creation_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &kafka.DescribeClusterOperationV2Input{
+		ClusterOperationArn: aws.String(operationArn),
+	}
+
+	op, err := svc.DescribeClusterOperationV2(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_msk_cluster_operation.getKafkaClusterOperationV2", "api_error", err)
+		return nil, err
+	}
+
+	return *op.ClusterOperationInfo, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func kafkaClusterOperationVpcConnectionInfo(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	operation, ok := d.HydrateItem.(types.ClusterOperationV2)
+	if !ok {
+		return nil, nil
+	}
+
+	if operation.Provisioned != nil {
+		return operation.Provisioned.VpcConnectionInfo, nil
+	}
+	if operation.Serverless != nil {
+		return operation.Serverless.VpcConnectionInfo, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,227 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
+
+	kafkav1 "github.com/aws/aws-sdk-go/service/kafka"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMSKConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_msk_configuration",
+		Description: "AWS Managed Streaming for Apache Kafka Configuration",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			Hydrate:    getKafkaConfiguration,
+			Tags:       map[string]string{"service": "kafka", "action": "DescribeConfiguration"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException", "BadRequestException"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listKafkaConfigurations,
+			Tags:    map[string]string{"service": "kafka", "action": "ListConfigurations"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getKafkaConfigurationRevision,
+				Tags: map[string]string{"service": "kafka", "action": "DescribeConfigurationRevision"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(kafkav1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the configuration. The possible states are ACTIVE, DELETING, and DELETE_FAILED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time when the configuration was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "kafka_versions",
+				Description: "An array of the versions of Apache Kafka with which you can use this MSK configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "latest_revision",
+				Description: "Latest revision of the configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "server_properties",
+				Description: "The contents of the server.properties file of the latest revision.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKafkaConfigurationRevision,
+				Transform:   transform.FromField("ServerProperties").Transform(transform.ToString),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listKafkaConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := KafkaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_msk_configuration.listKafkaConfigurations", "service_creation_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	input := &kafka.ListConfigurationsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := kafka.NewListConfigurationsPaginator(svc, input, func(o *kafka.ListConfigurationsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_msk_configuration.listKafkaConfigurations", "api_error", err)
+			return nil, err
+		}
+
+		for _, configuration := range output.Configurations {
+			d.StreamListItem(ctx, configuration)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getKafkaConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn := d.EqualsQualString("arn")
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := KafkaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_msk_configuration.getKafkaConfiguration", "service_creation_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &kafka.DescribeConfigurationInput{
+		Arn: aws.String(arn),
+	}
+
+	op, err := svc.DescribeConfiguration(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_msk_configuration.getKafkaConfiguration", "api_error", err)
+		return nil, err
+	}
+
+	return types.Configuration{
+		Arn:            op.Arn,
+		CreationTime:   op.CreationTime,
+		Description:    op.Description,
+		KafkaVersions:  op.KafkaVersions,
+		LatestRevision: op.LatestRevision,
+		Name:           op.Name,
+		State:          op.State,
+	}, nil
+}
+
+func getKafkaConfigurationRevision(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	configuration := h.Item.(types.Configuration)
+	if configuration.LatestRevision == nil || configuration.LatestRevision.Revision == nil {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := KafkaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_msk_configuration.getKafkaConfigurationRevision", "service_creation_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &kafka.DescribeConfigurationRevisionInput{
+		Arn:      configuration.Arn,
+		Revision: configuration.LatestRevision.Revision,
+	}
+
+	op, err := svc.DescribeConfigurationRevision(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_msk_configuration.getKafkaConfigurationRevision", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
+
+	redshiftv1 "github.com/aws/aws-sdk-go/service/redshift"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsRedshiftScheduledAction(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_redshift_scheduled_action",
+		Description: "AWS Redshift Scheduled Action",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("scheduled_action_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ScheduledActionNotFound"}),
+			},
+			Hydrate: getRedshiftScheduledAction,
+			Tags:    map[string]string{"service": "redshift", "action": "DescribeScheduledActions"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRedshiftScheduledActions,
+			Tags:    map[string]string{"service": "redshift", "action": "DescribeScheduledActions"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "state", Require: plugin.Optional},
+				{Name: "target_action_type", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(redshiftv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "scheduled_action_name",
+				Description: "The name of the scheduled action.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scheduled_action_description",
+				Description: "The description of the scheduled action.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the scheduled action. Can be ACTIVE or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_action_type",
+				Description: "The type of the action that is scheduled. Can be ResizeCluster, PauseCluster or ResumeCluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TargetAction").Transform(redshiftScheduledActionTargetActionType),
+			},
+			{
+				Name:        "schedule",
+				Description: "The schedule for a one-time (at format) or recurring (cron format) scheduled action.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "iam_role",
+				Description: "The IAM role to assume to run the scheduled action.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The start time in UTC when the schedule is active. Before this time, the scheduled action does not trigger.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_time",
+				Description: "The end time in UTC when the schedule is no longer active. After this time, the scheduled action does not trigger.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "next_invocations",
+				Description: "List of times when the scheduled action will run.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "target_action",
+				Description: "A JSON format string of the Amazon Redshift API operation with input parameters.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduledActionName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRedshiftScheduledActions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := RedshiftClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_redshift_scheduled_action.listRedshiftScheduledActions", "connection_error", err)
+		return nil, err
+	}
+
+	input := &redshift.DescribeScheduledActionsInput{
+		MaxRecords: aws.Int32(100),
+	}
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < *input.MaxRecords {
+			if limit < 20 {
+				input.MaxRecords = aws.Int32(20)
+			} else {
+				input.MaxRecords = aws.Int32(limit)
+			}
+		}
+	}
+
+	if d.EqualsQualString("state") != "" {
+		input.Active = aws.Bool(d.EqualsQualString("state") == string(types.ScheduledActionStateActive))
+	}
+	if d.EqualsQualString("target_action_type") != "" {
+		input.TargetActionType = types.ScheduledActionTypeValues(d.EqualsQualString("target_action_type"))
+	}
+
+	// List call
+	paginator := redshift.NewDescribeScheduledActionsPaginator(svc, input, func(o *redshift.DescribeScheduledActionsPaginatorOptions) {
+		o.Limit = *input.MaxRecords
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_redshift_scheduled_action.listRedshiftScheduledActions", "api_error", err)
+			return nil, err
+		}
+
+		for _, items := range output.ScheduledActions {
+			d.StreamListItem(ctx, items)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRedshiftScheduledAction(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := RedshiftClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_redshift_scheduled_action.getRedshiftScheduledAction", "connection_error", err)
+		return nil, err
+	}
+
+	name := d.EqualsQuals["scheduled_action_name"].GetStringValue()
+
+	// Return nil, if no input provided
+	if name == "" {
+		return nil, nil
+	}
+
+	// Build the params
+	params := &redshift.DescribeScheduledActionsInput{
+		ScheduledActionName: aws.String(name),
+	}
+
+	// Get call
+	data, err := svc.DescribeScheduledActions(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_redshift_scheduled_action.getRedshiftScheduledAction", "api_error", err)
+		return nil, err
+	}
+
+	if len(data.ScheduledActions) > 0 {
+		return data.ScheduledActions[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func redshiftScheduledActionTargetActionType(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	targetAction, ok := d.Value.(*types.ScheduledActionType)
+	if !ok || targetAction == nil {
+		return nil, nil
+	}
+
+	switch {
+	case targetAction.ResizeCluster != nil:
+		return types.ScheduledActionTypeValuesResizeCluster, nil
+	case targetAction.PauseCluster != nil:
+		return types.ScheduledActionTypeValuesPauseCluster, nil
+	case targetAction.ResumeCluster != nil:
+		return types.ScheduledActionTypeValuesResumeCluster, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,229 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
+
+	redshiftv1 "github.com/aws/aws-sdk-go/service/redshift"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsRedshiftUsageLimit(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_redshift_usage_limit",
+		Description: "AWS Redshift Usage Limit",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("usage_limit_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"UsageLimitNotFound"}),
+			},
+			Hydrate: getRedshiftUsageLimit,
+			Tags:    map[string]string{"service": "redshift", "action": "DescribeUsageLimits"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRedshiftUsageLimits,
+			Tags:    map[string]string{"service": "redshift", "action": "DescribeUsageLimits"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "cluster_identifier", Require: plugin.Optional},
+				{Name: "feature_type", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(redshiftv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "usage_limit_id",
+				Description: "The identifier of the usage limit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cluster_identifier",
+				Description: "The identifier of the cluster with a usage limit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "feature_type",
+				Description: "The Amazon Redshift feature to which the limit applies. Can be spectrum, concurrency-scaling or cross-region-datasharing.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "limit_type",
+				Description: "The type of limit. Can be time (in minutes) or data-scanned (in TB).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "amount",
+				Description: "The limit amount. If time-based, this amount is in minutes. If data-based, this amount is in terabytes (TB).",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "period",
+				Description: "The time period that the amount applies to. Can be daily, weekly or monthly.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "breach_action",
+				Description: "The action that Amazon Redshift takes when the limit is reached. Can be log, emit-metric or disable.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the usage limit.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UsageLimitId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(redshiftUsageLimitTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRedshiftUsageLimitAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRedshiftUsageLimits(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := RedshiftClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_redshift_usage_limit.listRedshiftUsageLimits", "connection_error", err)
+		return nil, err
+	}
+
+	input := &redshift.DescribeUsageLimitsInput{
+		MaxRecords: aws.Int32(100),
+	}
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < *input.MaxRecords {
+			if limit < 20 {
+				input.MaxRecords = aws.Int32(20)
+			} else {
+				input.MaxRecords = aws.Int32(limit)
+			}
+		}
+	}
+
+	if d.EqualsQualString("cluster_identifier") != "" {
+		input.ClusterIdentifier = aws.String(d.EqualsQualString("cluster_identifier"))
+	}
+	if d.EqualsQualString("feature_type") != "" {
+		input.FeatureType = types.UsageLimitFeatureType(d.EqualsQualString("feature_type"))
+	}
+
+	// List call
+	paginator := redshift.NewDescribeUsageLimitsPaginator(svc, input, func(o *redshift.DescribeUsageLimitsPaginatorOptions) {
+		o.Limit = *input.MaxRecords
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_redshift_usage_limit.listRedshiftUsageLimits", "api_error", err)
+			return nil, err
+		}
+
+		for _, items := range output.UsageLimits {
+			d.StreamListItem(ctx, items)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRedshiftUsageLimit(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := RedshiftClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_redshift_usage_limit.getRedshiftUsageLimit", "connection_error", err)
+		return nil, err
+	}
+
+	id := d.EqualsQuals["usage_limit_id"].GetStringValue()
+
+	// Return nil, if no input provided
+	if id == "" {
+		return nil, nil
+	}
+
+	// Build the params
+	params := &redshift.DescribeUsageLimitsInput{
+		UsageLimitId: aws.String(id),
+	}
+
+	// Get call
+	data, err := svc.DescribeUsageLimits(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_redshift_usage_limit.getRedshiftUsageLimit", "api_error", err)
+		return nil, err
+	}
+
+	if len(data.UsageLimits) > 0 {
+		return data.UsageLimits[0], nil
+	}
+	return nil, nil
+}
+
+func getRedshiftUsageLimitAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+	usageLimit := h.Item.(types.UsageLimit)
+
+	c, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_redshift_usage_limit.getRedshiftUsageLimitAkas", "getCommonColumns_error", err)
+		return nil, err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+	aka := "arn:" + commonColumnData.Partition + ":redshift:" + region + ":" + commonColumnData.AccountId + ":usagelimit:" + *usageLimit.UsageLimitId
+
+	return []string{aka}, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func redshiftUsageLimitTurbotTags(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	usageLimit := d.HydrateItem.(types.UsageLimit)
+
+	if len(usageLimit.Tags) > 0 {
+		turbotTagsMap := map[string]string{}
+		for _, i := range usageLimit.Tags {
+			turbotTagsMap[*i.Key] = *i.Value
+		}
+		return turbotTagsMap, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,206 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53CidrCollection(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_cidr_collection",
+		Description: "AWS Route53 CIDR Collection",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getRoute53CidrCollection,
+			Tags:       map[string]string{"service": "route53", "action": "ListCidrCollections"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRoute53CidrCollections,
+			Tags:    map[string]string{"service": "route53", "action": "ListCidrCollections"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: listRoute53CidrCollectionBlocks,
+				Tags: map[string]string{"service": "route53", "action": "ListCidrBlocks"},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the CIDR collection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique ID of the CIDR collection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the CIDR collection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "A sequential counter that Route 53 sets to 1 when you create a CIDR collection and increments by 1 each time you update settings for the CIDR collection.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "cidr_blocks",
+				Description: "The CIDR blocks in the collection, along with the location each block belongs to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listRoute53CidrCollectionBlocks,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRoute53CidrCollections(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := Route53Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_cidr_collection.listRoute53CidrCollections", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &route53.ListCidrCollectionsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := route53.NewListCidrCollectionsPaginator(svc, input, func(o *route53.ListCidrCollectionsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_cidr_collection.listRoute53CidrCollections", "api_error", err)
+			return nil, err
+		}
+
+		for _, collection := range output.CidrCollections {
+			d.StreamListItem(ctx, collection)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// There is no API to describe a single CIDR collection, so the get call
+// pages through ListCidrCollections until it finds a matching ID
+func getRoute53CidrCollection(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// Empty check
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := Route53Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_cidr_collection.getRoute53CidrCollection", "connection_error", err)
+		return nil, err
+	}
+
+	paginator := route53.NewListCidrCollectionsPaginator(svc, &route53.ListCidrCollectionsInput{}, func(o *route53.ListCidrCollectionsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_cidr_collection.getRoute53CidrCollection", "api_error", err)
+			return nil, err
+		}
+
+		for _, collection := range output.CidrCollections {
+			if aws.ToString(collection.Id) == id {
+				return collection, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func listRoute53CidrCollectionBlocks(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	collection := h.Item.(types.CollectionSummary)
+
+	// Create session
+	svc, err := Route53Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_cidr_collection.listRoute53CidrCollectionBlocks", "connection_error", err)
+		return nil, err
+	}
+
+	input := &route53.ListCidrBlocksInput{
+		CollectionId: collection.Id,
+	}
+
+	paginator := route53.NewListCidrBlocksPaginator(svc, input, func(o *route53.ListCidrBlocksPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	var cidrBlocks []types.CidrBlockSummary
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_cidr_collection.listRoute53CidrCollectionBlocks", "api_error", err)
+			return nil, err
+		}
+		cidrBlocks = append(cidrBlocks, output.CidrBlocks...)
+	}
+
+	return cidrBlocks, nil
+}
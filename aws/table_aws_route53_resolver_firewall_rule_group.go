@@ -0,0 +1,336 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	route53resolverv1 "github.com/aws/aws-sdk-go/service/route53resolver"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53ResolverFirewallRuleGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_resolver_firewall_rule_group",
+		Description: "AWS Route53 Resolver Firewall Rule Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getRoute53ResolverFirewallRuleGroup,
+			Tags:    map[string]string{"service": "route53resolver", "action": "GetFirewallRuleGroup"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRoute53ResolverFirewallRuleGroups,
+			Tags:    map[string]string{"service": "route53resolver", "action": "ListFirewallRuleGroups"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getRoute53ResolverFirewallRuleGroup,
+				Tags: map[string]string{"service": "route53resolver", "action": "GetFirewallRuleGroup"},
+			},
+			{
+				Func: listRoute53ResolverFirewallRules,
+				Tags: map[string]string{"service": "route53resolver", "action": "ListFirewallRules"},
+			},
+			{
+				Func: getRoute53ResolverFirewallRuleGroupTags,
+				Tags: map[string]string{"service": "route53resolver", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(route53resolverv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the rule group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the rule group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The ARN (Amazon Resource Name) of the rule group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the rule group.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getRoute53ResolverFirewallRuleGroup,
+			},
+			{
+				Name:        "status_message",
+				Description: "Additional information about the status of the rule group, if available.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getRoute53ResolverFirewallRuleGroup,
+			},
+			{
+				Name:        "rule_count",
+				Description: "The number of rules in the rule group.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getRoute53ResolverFirewallRuleGroup,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The Amazon Web Services account ID for the account that created the rule group. When a rule group is shared with your account, this is the account that has shared the rule group with you.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "share_status",
+				Description: "Whether the rule group is shared with other Amazon Web Services accounts, or was shared with the current account by another Amazon Web Services account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creator_request_id",
+				Description: "A unique string defined by you to identify the request. This allows you to retry failed requests without the risk of running the operation twice.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the rule group was created, in Unix time format and Coordinated Universal Time (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getRoute53ResolverFirewallRuleGroup,
+			},
+			{
+				Name:        "modification_time",
+				Description: "The date and time that the rule group was last modified, in Unix time format and Coordinated Universal Time (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getRoute53ResolverFirewallRuleGroup,
+			},
+			{
+				Name:        "rules",
+				Description: "A list of the rules that are defined in the rule group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listRoute53ResolverFirewallRules,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the rule group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53ResolverFirewallRuleGroupTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53ResolverFirewallRuleGroupTags,
+				Transform:   transform.FromField("Tags").Transform(route53resolverRuleTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRoute53ResolverFirewallRuleGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.listRoute53ResolverFirewallRuleGroups", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &route53resolver.ListFirewallRuleGroupsInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	// List call
+	paginator := route53resolver.NewListFirewallRuleGroupsPaginator(svc, input, func(o *route53resolver.ListFirewallRuleGroupsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.listRoute53ResolverFirewallRuleGroups", "api_error", err)
+			return nil, err
+		}
+
+		for _, ruleGroup := range output.FirewallRuleGroups {
+			d.StreamListItem(ctx, ruleGroup)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRoute53ResolverFirewallRuleGroup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		id = route53ResolverFirewallRuleGroupID(h.Item)
+	} else {
+		id = d.EqualsQualString("id")
+	}
+
+	// Empty check
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.getRoute53ResolverFirewallRuleGroup", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build the params
+	params := &route53resolver.GetFirewallRuleGroupInput{
+		FirewallRuleGroupId: aws.String(id),
+	}
+
+	// Get call
+	data, err := svc.GetFirewallRuleGroup(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.getRoute53ResolverFirewallRuleGroup", "api_error", err)
+		return nil, err
+	}
+	return data.FirewallRuleGroup, nil
+}
+
+func listRoute53ResolverFirewallRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := route53ResolverFirewallRuleGroupID(h.Item)
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.listRoute53ResolverFirewallRules", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build the params
+	params := &route53resolver.ListFirewallRulesInput{
+		FirewallRuleGroupId: aws.String(id),
+		MaxResults:          aws.Int32(100),
+	}
+
+	paginator := route53resolver.NewListFirewallRulesPaginator(svc, params, func(o *route53resolver.ListFirewallRulesPaginatorOptions) {
+		o.Limit = 100
+		o.StopOnDuplicateToken = true
+	})
+
+	var rules []types.FirewallRule
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.listRoute53ResolverFirewallRules", "api_error", err)
+			return nil, err
+		}
+		rules = append(rules, output.FirewallRules...)
+	}
+
+	return rules, nil
+}
+
+func getRoute53ResolverFirewallRuleGroupTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn string
+	switch item := h.Item.(type) {
+	case types.FirewallRuleGroupMetadata:
+		arn = aws.ToString(item.Arn)
+	case *types.FirewallRuleGroup:
+		arn = aws.ToString(item.Arn)
+	}
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.getRoute53ResolverFirewallRuleGroupTags", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build the params
+	params := &route53resolver.ListTagsForResourceInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	// Get call
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.getRoute53ResolverFirewallRuleGroupTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// UTILITY FUNCTION
+
+// The list call returns rule group metadata while the get call returns the
+// full rule group, so hydrate functions read the ID from either type
+func route53ResolverFirewallRuleGroupID(item interface{}) string {
+	switch item := item.(type) {
+	case types.FirewallRuleGroupMetadata:
+		return aws.ToString(item.Id)
+	case *types.FirewallRuleGroup:
+		return aws.ToString(item.Id)
+	}
+	return ""
+}
@@ -0,0 +1,231 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+
+	s3controlv1 "github.com/aws/aws-sdk-go/service/s3control"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3AccessGrant(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_access_grant",
+		Description: "AWS S3 Access Grant",
+		List: &plugin.ListConfig{
+			Hydrate: listS3AccessGrants,
+			Tags:    map[string]string{"service": "s3", "action": "ListAccessGrants"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"AccessGrantsInstanceNotExistsError", "InvalidRequest"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "grantee_type", Require: plugin.Optional},
+				{Name: "permission", Require: plugin.Optional},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("access_grant_id"),
+			Hydrate:    getS3AccessGrant,
+			Tags:       map[string]string{"service": "s3", "action": "GetAccessGrant"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"AccessGrantsInstanceNotExistsError", "AccessGrantNotFound", "NotFoundException", "InvalidRequest"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(s3controlv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "access_grant_id",
+				Description: "The ID of the access grant.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "access_grant_arn",
+				Description: "The Amazon Resource Name (ARN) of the access grant.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "permission",
+				Description: "The type of permission that was granted in the access grant. Can be one of READ, WRITE or READWRITE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "grantee_type",
+				Description: "The type of the grantee to which access has been granted. Can be one of IAM, DIRECTORY_USER or DIRECTORY_GROUP.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Grantee.GranteeType"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time when you created the S3 Access Grants instance.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "grant_scope",
+				Description: "The S3 path of the data to which you are granting access. It is the result of appending the Subprefix to the location scope.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "access_grants_location_id",
+				Description: "The ID of the registered location to which you are granting access.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "application_arn",
+				Description: "The Amazon Resource Name (ARN) of an Amazon Web Services IAM Identity Center application associated with your Identity Center instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "access_grants_location_configuration",
+				Description: "The configuration options of the grant location. The grant location is the S3 path to the data to which you are granting access.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "grantee",
+				Description: "The user, group, or role to which you are granting access.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessGrantId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AccessGrantArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listS3AccessGrants(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get account details
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_access_grant.listS3AccessGrants", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	region := d.EqualsQualString(matrixKeyRegion)
+	// Create Session
+	svc, err := S3ControlClient(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_access_grant.listS3AccessGrants", "client_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	maxItems := int32(1000)
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			maxItems = limit
+		}
+	}
+
+	input := &s3control.ListAccessGrantsInput{
+		AccountId:  aws.String(commonColumnData.AccountId),
+		MaxResults: maxItems,
+	}
+
+	if d.EqualsQualString("grantee_type") != "" {
+		input.GranteeType = types.GranteeType(d.EqualsQualString("grantee_type"))
+	}
+	if d.EqualsQualString("permission") != "" {
+		input.Permission = types.Permission(d.EqualsQualString("permission"))
+	}
+
+	paginator := s3control.NewListAccessGrantsPaginator(svc, input, func(o *s3control.ListAccessGrantsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_access_grant.listS3AccessGrants", "api_error", err)
+			return nil, err
+		}
+
+		for _, accessGrant := range output.AccessGrantsList {
+			d.StreamListItem(ctx, accessGrant)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getS3AccessGrant(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+	accessGrantID := d.EqualsQualString("access_grant_id")
+
+	// Empty check
+	if accessGrantID == "" {
+		return nil, nil
+	}
+
+	// Get account details
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_access_grant.getS3AccessGrant", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create Session
+	svc, err := S3ControlClient(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_access_grant.getS3AccessGrant", "client_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &s3control.GetAccessGrantInput{
+		AccountId:     aws.String(commonColumnData.AccountId),
+		AccessGrantId: aws.String(accessGrantID),
+	}
+
+	op, err := svc.GetAccessGrant(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_access_grant.getS3AccessGrant", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -107,6 +107,21 @@ func tableAwsS3Bucket(_ context.Context) *plugin.Table {
 				Depends: []plugin.HydrateFunc{getBucketRegion},
 				Tags:    map[string]string{"service": "s3", "action": "GetBucketWebsite"},
 			},
+			{
+				Func:    getBucketRequestPayment,
+				Depends: []plugin.HydrateFunc{getBucketRegion},
+				Tags:    map[string]string{"service": "s3", "action": "GetBucketRequestPayment"},
+			},
+			{
+				Func:    getBucketAccelerateConfiguration,
+				Depends: []plugin.HydrateFunc{getBucketRegion},
+				Tags:    map[string]string{"service": "s3", "action": "GetBucketAccelerateConfiguration"},
+			},
+			{
+				Func:    getBucketIntelligentTieringConfigurations,
+				Depends: []plugin.HydrateFunc{getBucketRegion},
+				Tags:    map[string]string{"service": "s3", "action": "ListBucketIntelligentTieringConfigurations"},
+			},
 		},
 		Columns: awsAccountColumns([]*plugin.Column{
 			{
@@ -190,6 +205,13 @@ func tableAwsS3Bucket(_ context.Context) *plugin.Table {
 				Hydrate:     getBucketEncryption,
 				Transform:   transform.FromField("ServerSideEncryptionConfiguration"),
 			},
+			{
+				Name:        "acceleration_status",
+				Description: "The transfer acceleration state of the bucket. Can be Enabled or Suspended, or null if transfer acceleration has never been configured.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBucketAccelerateConfiguration,
+				Transform:   transform.FromField("Status").Transform(transform.NullIfZeroValue),
+			},
 			{
 				Name:        "acl",
 				Description: "The access control list (ACL) of a bucket.",
@@ -197,6 +219,13 @@ func tableAwsS3Bucket(_ context.Context) *plugin.Table {
 				Hydrate:     getBucketACL,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "intelligent_tiering_configurations",
+				Description: "The S3 Intelligent-Tiering configurations of the bucket.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBucketIntelligentTieringConfigurations,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "lifecycle_rules",
 				Description: "The lifecycle configuration information of the bucket.",
@@ -245,6 +274,13 @@ func tableAwsS3Bucket(_ context.Context) *plugin.Table {
 				Hydrate:     getBucketReplication,
 				Transform:   transform.FromField("ReplicationConfiguration"),
 			},
+			{
+				Name:        "request_payment_configuration",
+				Description: "Specifies who pays for the download and request fees. Can be BucketOwner or Requester.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBucketRequestPayment,
+				Transform:   transform.FromField("Payer"),
+			},
 			{
 				Name:        "website_configuration",
 				Description: "The website configuration information of the bucket.",
@@ -738,6 +774,84 @@ func getBucketWebsite(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	return bucketwebsites, nil
 }
 
+func getBucketRequestPayment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucketName := h.Item.(types.Bucket).Name
+	bucketRegion := h.HydrateResults["getBucketRegion"].(string)
+
+	// Create client
+	svc, err := S3Client(ctx, d, bucketRegion)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket.getBucketRequestPayment", "client_error", err)
+		return nil, err
+	}
+
+	params := &s3.GetBucketRequestPaymentInput{Bucket: bucketName}
+
+	requestPayment, err := svc.GetBucketRequestPayment(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket.getBucketRequestPayment", "api_error", err)
+		return nil, err
+	}
+
+	return requestPayment, nil
+}
+
+func getBucketAccelerateConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucketName := h.Item.(types.Bucket).Name
+	bucketRegion := h.HydrateResults["getBucketRegion"].(string)
+
+	// Create client
+	svc, err := S3Client(ctx, d, bucketRegion)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket.getBucketAccelerateConfiguration", "client_error", err)
+		return nil, err
+	}
+
+	params := &s3.GetBucketAccelerateConfigurationInput{Bucket: bucketName}
+
+	accelerateConfiguration, err := svc.GetBucketAccelerateConfiguration(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket.getBucketAccelerateConfiguration", "api_error", err)
+		return nil, err
+	}
+
+	return accelerateConfiguration, nil
+}
+
+func getBucketIntelligentTieringConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucketName := h.Item.(types.Bucket).Name
+	bucketRegion := h.HydrateResults["getBucketRegion"].(string)
+
+	// Create client
+	svc, err := S3Client(ctx, d, bucketRegion)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket.getBucketIntelligentTieringConfigurations", "client_error", err)
+		return nil, err
+	}
+
+	params := &s3.ListBucketIntelligentTieringConfigurationsInput{Bucket: bucketName}
+
+	configurations := []types.IntelligentTieringConfiguration{}
+	pageLeft := true
+	for pageLeft {
+		op, err := svc.ListBucketIntelligentTieringConfigurations(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_bucket.getBucketIntelligentTieringConfigurations", "api_error", err)
+			return nil, err
+		}
+
+		configurations = append(configurations, op.IntelligentTieringConfigurationList...)
+
+		if op.NextContinuationToken != nil {
+			params.ContinuationToken = op.NextContinuationToken
+		} else {
+			pageLeft = false
+		}
+	}
+
+	return configurations, nil
+}
+
 func getBucketARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	bucketName := h.Item.(types.Bucket).Name
 
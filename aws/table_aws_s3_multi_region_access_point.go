@@ -36,6 +36,12 @@ func tableAwsS3MultiRegionAccessPoint(_ context.Context) *plugin.Table {
 				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NoSuchMultiRegionAccessPoint", "InvalidParameter", "InvalidRequest"}),
 			},
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getS3MultiRegionAccessPointPolicy,
+				Tags: map[string]string{"service": "s3", "action": "GetMultiRegionAccessPointPolicy"},
+			},
+		},
 		Columns: awsGlobalRegionColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -67,6 +73,20 @@ func tableAwsS3MultiRegionAccessPoint(_ context.Context) *plugin.Table {
 				Description: "A collection of the Regions and buckets associated with the Multi-Region Access Point.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "policy",
+				Description: "The established and proposed access control policies for the Multi-Region Access Point.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3MultiRegionAccessPointPolicy,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "policy_std",
+				Description: "Contains the established policy in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3MultiRegionAccessPointPolicy,
+				Transform:   transform.FromField("Established.Policy").Transform(policyToCanonical),
+			},
 
 			// Steampipe standard columns
 			{
@@ -204,6 +224,53 @@ func getS3MultiRegionAccessPoint(ctx context.Context, d *plugin.QueryData, h *pl
 	return item.AccessPoint, nil
 }
 
+func getS3MultiRegionAccessPointPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name string
+	switch item := h.Item.(type) {
+	case types.MultiRegionAccessPointReport:
+		name = *item.Name
+	case *types.MultiRegionAccessPointReport:
+		name = *item.Name
+	}
+
+	// Get account details
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_multi_region_access_point.getS3MultiRegionAccessPointPolicy", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	ownerAccountId := d.EqualsQuals["account_id"].GetStringValue()
+	if ownerAccountId == "" {
+		ownerAccountId = commonColumnData.AccountId
+	}
+
+	// Create Session
+	svc, err := S3ControlMultiRegionAccessClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_multi_region_access_point.getS3MultiRegionAccessPointPolicy", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		return nil, nil
+	}
+
+	// Build params
+	params := &s3control.GetMultiRegionAccessPointPolicyInput{
+		Name:      aws.String(name),
+		AccountId: aws.String(ownerAccountId),
+	}
+
+	op, err := svc.GetMultiRegionAccessPointPolicy(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_multi_region_access_point.getS3MultiRegionAccessPointPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return op.Policy, nil
+}
+
 func getMultiRegionAccessPointArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	accessPointName := multiRegionAccessPointName(h.Item)
 
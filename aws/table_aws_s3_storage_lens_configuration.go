@@ -0,0 +1,310 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+
+	s3controlv1 "github.com/aws/aws-sdk-go/service/s3control"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3StorageLensConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_storage_lens_configuration",
+		Description: "AWS S3 Storage Lens Configuration",
+		List: &plugin.ListConfig{
+			Hydrate: listS3StorageLensConfigurations,
+			Tags:    map[string]string{"service": "s3", "action": "ListStorageLensConfigurations"},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getS3StorageLensConfiguration,
+			Tags:       map[string]string{"service": "s3", "action": "GetStorageLensConfiguration"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NoSuchConfiguration", "InvalidParameter", "InvalidRequest"}),
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getS3StorageLensConfiguration,
+				Tags: map[string]string{"service": "s3", "action": "GetStorageLensConfiguration"},
+			},
+			{
+				Func: getS3StorageLensConfigurationTagging,
+				Tags: map[string]string{"service": "s3", "action": "GetStorageLensConfigurationTagging"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(s3controlv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "A container for the Amazon S3 Storage Lens configuration ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the S3 Storage Lens configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StorageLensArn"),
+			},
+			{
+				Name:        "is_enabled",
+				Description: "A container for whether the S3 Storage Lens configuration is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "account_level",
+				Description: "A container for all the account-level configurations of your S3 Storage Lens configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3StorageLensConfiguration,
+			},
+			{
+				Name:        "include",
+				Description: "A container for what is included in this configuration. This container can only be valid if there is no exclude container submitted, and it's not empty.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3StorageLensConfiguration,
+			},
+			{
+				Name:        "exclude",
+				Description: "A container for what is excluded in this configuration. This container can only be valid if there is no include container submitted, and it's not empty.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3StorageLensConfiguration,
+			},
+			{
+				Name:        "data_export",
+				Description: "A container to specify the properties of your S3 Storage Lens metrics export including, the destination, schema and format.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3StorageLensConfiguration,
+			},
+			{
+				Name:        "aws_org",
+				Description: "A container for the Amazon Web Services organization for this S3 Storage Lens configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3StorageLensConfiguration,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the S3 Storage Lens configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3StorageLensConfigurationTagging,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3StorageLensConfigurationTagging,
+				Transform:   transform.FromField("Tags").Transform(s3StorageLensConfigurationTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("StorageLensArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listS3StorageLensConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get account details
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_storage_lens_configuration.listS3StorageLensConfigurations", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	region := d.EqualsQualString(matrixKeyRegion)
+	// Create Session
+	svc, err := S3ControlClient(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_storage_lens_configuration.listS3StorageLensConfigurations", "client_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// The API does not support a page size parameter
+	input := &s3control.ListStorageLensConfigurationsInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+	}
+
+	paginator := s3control.NewListStorageLensConfigurationsPaginator(svc, input, func(o *s3control.ListStorageLensConfigurationsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_storage_lens_configuration.listS3StorageLensConfigurations", "api_error", err)
+			return nil, err
+		}
+
+		for _, configuration := range output.StorageLensConfigurationList {
+			// Only return the configurations homed in the current region to avoid duplicate rows
+			if configuration.HomeRegion != nil && *configuration.HomeRegion != region {
+				continue
+			}
+
+			d.StreamListItem(ctx, configuration)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getS3StorageLensConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	var id string
+	if h.Item != nil {
+		id = *h.Item.(types.ListStorageLensConfigurationEntry).Id
+	} else {
+		id = d.EqualsQualString("id")
+	}
+
+	// Empty check
+	if id == "" {
+		return nil, nil
+	}
+
+	// Get account details
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_storage_lens_configuration.getS3StorageLensConfiguration", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create Session
+	svc, err := S3ControlClient(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_storage_lens_configuration.getS3StorageLensConfiguration", "client_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &s3control.GetStorageLensConfigurationInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+		ConfigId:  aws.String(id),
+	}
+
+	op, err := svc.GetStorageLensConfiguration(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_storage_lens_configuration.getS3StorageLensConfiguration", "api_error", err)
+		return nil, err
+	}
+
+	if op.StorageLensConfiguration == nil {
+		return nil, nil
+	}
+
+	// Return nil, if the configuration is not homed in the current region
+	if h.Item == nil && op.StorageLensConfiguration.StorageLensArn != nil {
+		arnData, _ := arn.Parse(*op.StorageLensConfiguration.StorageLensArn)
+		if arnData.Region != "" && arnData.Region != region {
+			return nil, nil
+		}
+	}
+
+	return op.StorageLensConfiguration, nil
+}
+
+func getS3StorageLensConfigurationTagging(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	var id string
+	switch item := h.Item.(type) {
+	case types.ListStorageLensConfigurationEntry:
+		id = *item.Id
+	case *types.StorageLensConfiguration:
+		id = *item.Id
+	}
+
+	// Get account details
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_storage_lens_configuration.getS3StorageLensConfigurationTagging", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create Session
+	svc, err := S3ControlClient(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_storage_lens_configuration.getS3StorageLensConfigurationTagging", "client_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &s3control.GetStorageLensConfigurationTaggingInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+		ConfigId:  aws.String(id),
+	}
+
+	op, err := svc.GetStorageLensConfigurationTagging(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_storage_lens_configuration.getS3StorageLensConfigurationTagging", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func s3StorageLensConfigurationTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]types.StorageLensTag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	// Get the resource tags
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,330 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+
+	sagemakerv1 "github.com/aws/aws-sdk-go/service/sagemaker"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSageMakerEndpoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_sagemaker_endpoint",
+		Description: "AWS Sagemaker Endpoint",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ValidationException", "NotFoundException"}),
+			},
+			Hydrate: getSagemakerEndpoint,
+			Tags:    map[string]string{"service": "sagemaker", "action": "DescribeEndpoint"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSagemakerEndpoints,
+			Tags:    map[string]string{"service": "sagemaker", "action": "ListEndpoints"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "endpoint_status", Require: plugin.Optional},
+				{Name: "creation_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getSagemakerEndpoint,
+				Tags: map[string]string{"service": "sagemaker", "action": "DescribeEndpoint"},
+			},
+			{
+				Func: listSageMakerEndpointTags,
+				Tags: map[string]string{"service": "sagemaker", "action": "ListTags"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(sagemakerv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EndpointName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EndpointArn"),
+			},
+			{
+				Name:        "endpoint_status",
+				Description: "The status of the endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "A timestamp that shows when the endpoint was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "A timestamp that shows when the endpoint was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "endpoint_config_name",
+				Description: "The name of the endpoint configuration associated with this endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSagemakerEndpoint,
+			},
+			{
+				Name:        "failure_reason",
+				Description: "If the status of the endpoint is Failed, the reason why it failed.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSagemakerEndpoint,
+			},
+			{
+				Name:        "production_variants",
+				Description: "An array of ProductionVariantSummary objects, one for each model hosted behind this endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerEndpoint,
+			},
+			{
+				Name:        "shadow_production_variants",
+				Description: "An array of ProductionVariantSummary objects, one for each model that you want to host at this endpoint in shadow mode with production traffic replicated from the model specified on ProductionVariants.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerEndpoint,
+			},
+			{
+				Name:        "data_capture_config",
+				Description: "The currently active data capture configuration used by your endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerEndpoint,
+			},
+			{
+				Name:        "async_inference_config",
+				Description: "The configuration of the endpoint for asynchronous inference.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerEndpoint,
+			},
+			{
+				Name:        "explainer_config",
+				Description: "The configuration parameters for an explainer.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerEndpoint,
+			},
+			{
+				Name:        "last_deployment_config",
+				Description: "The most recent deployment configuration for the endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerEndpoint,
+			},
+			{
+				Name:        "pending_deployment_summary",
+				Description: "Returns the summary of an in-progress deployment.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerEndpoint,
+			},
+			{
+				Name:        "tags_src",
+				Description: "The list of tags for the endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listSageMakerEndpointTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EndpointName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listSageMakerEndpointTags,
+				Transform:   transform.FromValue().Transform(sageMakerTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("EndpointArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSagemakerEndpoints(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	svc, err := SageMakerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sagemaker_endpoint.listSagemakerEndpoints", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &sagemaker.ListEndpointsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	if d.EqualsQualString("endpoint_status") != "" {
+		input.StatusEquals = types.EndpointStatus(d.EqualsQualString("endpoint_status"))
+	}
+
+	quals := d.Quals
+	if quals["creation_time"] != nil {
+		for _, q := range quals["creation_time"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">=", ">":
+				input.CreationTimeAfter = aws.Time(timestamp)
+			case "<", "<=":
+				input.CreationTimeBefore = aws.Time(timestamp)
+			}
+		}
+	}
+
+	paginator := sagemaker.NewListEndpointsPaginator(svc, input, func(o *sagemaker.ListEndpointsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_sagemaker_endpoint.listSagemakerEndpoints", "api_error", err)
+			return nil, err
+		}
+
+		for _, items := range output.Endpoints {
+			d.StreamListItem(ctx, items)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSagemakerEndpoint(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get endpoint name
+	var endpointName string
+	if h.Item != nil {
+		endpointName = *h.Item.(types.EndpointSummary).EndpointName
+	} else {
+		endpointName = d.EqualsQuals["name"].GetStringValue()
+	}
+
+	// Create client
+	svc, err := SageMakerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sagemaker_endpoint.getSagemakerEndpoint", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build the params
+	params := &sagemaker.DescribeEndpointInput{
+		EndpointName: aws.String(endpointName),
+	}
+
+	// Get call
+	data, err := svc.DescribeEndpoint(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sagemaker_endpoint.getSagemakerEndpoint", "api_error", err)
+		return nil, err
+	}
+	return data, nil
+}
+
+func listSageMakerEndpointTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	endpointArn := sageMakerEndpointARN(h.Item)
+
+	// Create client
+	svc, err := SageMakerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sagemaker_endpoint.listSageMakerEndpointTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build the params
+	params := &sagemaker.ListTagsInput{
+		ResourceArn: aws.String(endpointArn),
+	}
+
+	pagesLeft := true
+	tags := []types.Tag{}
+	for pagesLeft {
+
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		keyTags, err := svc.ListTags(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_sagemaker_endpoint.listSageMakerEndpointTags", "api_error", err)
+			return nil, err
+		}
+		tags = append(tags, keyTags.Tags...)
+
+		if keyTags.NextToken != nil {
+			params.NextToken = keyTags.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return tags, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func sageMakerEndpointARN(item interface{}) string {
+	switch item := item.(type) {
+	case types.EndpointSummary:
+		return *item.EndpointArn
+	case *sagemaker.DescribeEndpointOutput:
+		return *item.EndpointArn
+	}
+	return ""
+}
@@ -0,0 +1,348 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+
+	sagemakerv1 "github.com/aws/aws-sdk-go/service/sagemaker"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSageMakerFeatureGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_sagemaker_feature_group",
+		Description: "AWS Sagemaker Feature Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ValidationException", "NotFoundException", "ResourceNotFound"}),
+			},
+			Hydrate: getSagemakerFeatureGroup,
+			Tags:    map[string]string{"service": "sagemaker", "action": "DescribeFeatureGroup"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSagemakerFeatureGroups,
+			Tags:    map[string]string{"service": "sagemaker", "action": "ListFeatureGroups"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "feature_group_status", Require: plugin.Optional},
+				{Name: "creation_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getSagemakerFeatureGroup,
+				Tags: map[string]string{"service": "sagemaker", "action": "DescribeFeatureGroup"},
+			},
+			{
+				Func: listSageMakerFeatureGroupTags,
+				Tags: map[string]string{"service": "sagemaker", "action": "ListTags"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(sagemakerv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the feature group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FeatureGroupName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the feature group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FeatureGroupArn"),
+			},
+			{
+				Name:        "feature_group_status",
+				Description: "The status of the feature group. Can be Creating, Created, CreateFailed, Deleting or DeleteFailed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "A timestamp indicating when the feature group was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "A free form description of the feature group.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSagemakerFeatureGroup,
+			},
+			{
+				Name:        "record_identifier_feature_name",
+				Description: "The name of the feature whose value uniquely identifies a record defined in the feature store.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSagemakerFeatureGroup,
+			},
+			{
+				Name:        "event_time_feature_name",
+				Description: "The name of the feature that stores the EventTime of a record in the feature group.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSagemakerFeatureGroup,
+			},
+			{
+				Name:        "role_arn",
+				Description: "The Amazon Resource Name (ARN) of the IAM execution role used to persist data into the offline store.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSagemakerFeatureGroup,
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "A timestamp indicating when the feature group was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getSagemakerFeatureGroup,
+			},
+			{
+				Name:        "failure_reason",
+				Description: "The reason that the feature group failed to be replicated in the offline store.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSagemakerFeatureGroup,
+			},
+			{
+				Name:        "online_store_total_size_bytes",
+				Description: "The size of the online store in bytes.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getSagemakerFeatureGroup,
+			},
+			{
+				Name:        "feature_definitions",
+				Description: "A list of the features in the feature group, each with a name and a type.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerFeatureGroup,
+			},
+			{
+				Name:        "online_store_config",
+				Description: "The configuration for the online store.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerFeatureGroup,
+			},
+			{
+				Name:        "offline_store_config",
+				Description: "The configuration of the offline store, including the S3 location and the Glue data catalog.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerFeatureGroup,
+			},
+			{
+				Name:        "offline_store_status",
+				Description: "The status of the offline store. Notifies you if replicating data into the offline store has failed.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "last_update_status",
+				Description: "The status of the last update made to the feature group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerFeatureGroup,
+			},
+			{
+				Name:        "throughput_config",
+				Description: "The throughput configuration of the feature group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerFeatureGroup,
+			},
+			{
+				Name:        "tags_src",
+				Description: "The list of tags for the feature group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listSageMakerFeatureGroupTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FeatureGroupName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listSageMakerFeatureGroupTags,
+				Transform:   transform.FromValue().Transform(sageMakerTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FeatureGroupArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSagemakerFeatureGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	svc, err := SageMakerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sagemaker_feature_group.listSagemakerFeatureGroups", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &sagemaker.ListFeatureGroupsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	if d.EqualsQualString("feature_group_status") != "" {
+		input.FeatureGroupStatusEquals = types.FeatureGroupStatus(d.EqualsQualString("feature_group_status"))
+	}
+
+	quals := d.Quals
+	if quals["creation_time"] != nil {
+		for _, q := range quals["creation_time"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">=", ">":
+				input.CreationTimeAfter = aws.Time(timestamp)
+			case "<", "<=":
+				input.CreationTimeBefore = aws.Time(timestamp)
+			}
+		}
+	}
+
+	paginator := sagemaker.NewListFeatureGroupsPaginator(svc, input, func(o *sagemaker.ListFeatureGroupsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_sagemaker_feature_group.listSagemakerFeatureGroups", "api_error", err)
+			return nil, err
+		}
+
+		for _, items := range output.FeatureGroupSummaries {
+			d.StreamListItem(ctx, items)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSagemakerFeatureGroup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get feature group name
+	var featureGroupName string
+	if h.Item != nil {
+		featureGroupName = *h.Item.(types.FeatureGroupSummary).FeatureGroupName
+	} else {
+		featureGroupName = d.EqualsQuals["name"].GetStringValue()
+	}
+
+	// Create client
+	svc, err := SageMakerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sagemaker_feature_group.getSagemakerFeatureGroup", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build the params
+	params := &sagemaker.DescribeFeatureGroupInput{
+		FeatureGroupName: aws.String(featureGroupName),
+	}
+
+	// Get call
+	data, err := svc.DescribeFeatureGroup(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sagemaker_feature_group.getSagemakerFeatureGroup", "api_error", err)
+		return nil, err
+	}
+	return data, nil
+}
+
+func listSageMakerFeatureGroupTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	featureGroupArn := sageMakerFeatureGroupARN(h.Item)
+
+	// Create client
+	svc, err := SageMakerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sagemaker_feature_group.listSageMakerFeatureGroupTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build the params
+	params := &sagemaker.ListTagsInput{
+		ResourceArn: aws.String(featureGroupArn),
+	}
+
+	pagesLeft := true
+	tags := []types.Tag{}
+	for pagesLeft {
+
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		keyTags, err := svc.ListTags(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_sagemaker_feature_group.listSageMakerFeatureGroupTags", "api_error", err)
+			return nil, err
+		}
+		tags = append(tags, keyTags.Tags...)
+
+		if keyTags.NextToken != nil {
+			params.NextToken = keyTags.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return tags, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func sageMakerFeatureGroupARN(item interface{}) string {
+	switch item := item.(type) {
+	case types.FeatureGroupSummary:
+		return *item.FeatureGroupArn
+	case *sagemaker.DescribeFeatureGroupOutput:
+		return *item.FeatureGroupArn
+	}
+	return ""
+}
@@ -0,0 +1,323 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+
+	sagemakerv1 "github.com/aws/aws-sdk-go/service/sagemaker"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSageMakerPipeline(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_sagemaker_pipeline",
+		Description: "AWS Sagemaker Pipeline",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ValidationException", "NotFoundException", "ResourceNotFound"}),
+			},
+			Hydrate: getSagemakerPipeline,
+			Tags:    map[string]string{"service": "sagemaker", "action": "DescribePipeline"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSagemakerPipelines,
+			Tags:    map[string]string{"service": "sagemaker", "action": "ListPipelines"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "creation_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getSagemakerPipeline,
+				Tags: map[string]string{"service": "sagemaker", "action": "DescribePipeline"},
+			},
+			{
+				Func: listSageMakerPipelineTags,
+				Tags: map[string]string{"service": "sagemaker", "action": "ListTags"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(sagemakerv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the pipeline.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PipelineName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the pipeline.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PipelineArn"),
+			},
+			{
+				Name:        "pipeline_display_name",
+				Description: "The display name of the pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pipeline_description",
+				Description: "The description of the pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pipeline_status",
+				Description: "The status of the pipeline execution.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSagemakerPipeline,
+			},
+			{
+				Name:        "role_arn",
+				Description: "The Amazon Resource Name (ARN) that the pipeline uses to execute.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The creation time of the pipeline.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The time that the pipeline was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_execution_time",
+				Description: "The last time that a pipeline execution began.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_run_time",
+				Description: "The time when the pipeline was last run.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getSagemakerPipeline,
+			},
+			{
+				Name:        "pipeline_definition",
+				Description: "The JSON pipeline definition.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerPipeline,
+				Transform:   transform.FromField("PipelineDefinition").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "parallelism_configuration",
+				Description: "Lists the parallelism configuration applied to the pipeline.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerPipeline,
+			},
+			{
+				Name:        "created_by",
+				Description: "Information about the user who created the pipeline.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerPipeline,
+			},
+			{
+				Name:        "last_modified_by",
+				Description: "Information about the user who last modified the pipeline.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerPipeline,
+			},
+			{
+				Name:        "tags_src",
+				Description: "The list of tags for the pipeline.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listSageMakerPipelineTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PipelineName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listSageMakerPipelineTags,
+				Transform:   transform.FromValue().Transform(sageMakerTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PipelineArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSagemakerPipelines(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	svc, err := SageMakerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sagemaker_pipeline.listSagemakerPipelines", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &sagemaker.ListPipelinesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	quals := d.Quals
+	if quals["creation_time"] != nil {
+		for _, q := range quals["creation_time"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">=", ">":
+				input.CreatedAfter = aws.Time(timestamp)
+			case "<", "<=":
+				input.CreatedBefore = aws.Time(timestamp)
+			}
+		}
+	}
+
+	paginator := sagemaker.NewListPipelinesPaginator(svc, input, func(o *sagemaker.ListPipelinesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_sagemaker_pipeline.listSagemakerPipelines", "api_error", err)
+			return nil, err
+		}
+
+		for _, items := range output.PipelineSummaries {
+			d.StreamListItem(ctx, items)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSagemakerPipeline(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get pipeline name
+	var pipelineName string
+	if h.Item != nil {
+		pipelineName = *h.Item.(types.PipelineSummary).PipelineName
+	} else {
+		pipelineName = d.EqualsQuals["name"].GetStringValue()
+	}
+
+	// Create client
+	svc, err := SageMakerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sagemaker_pipeline.getSagemakerPipeline", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build the params
+	params := &sagemaker.DescribePipelineInput{
+		PipelineName: aws.String(pipelineName),
+	}
+
+	// Get call
+	data, err := svc.DescribePipeline(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sagemaker_pipeline.getSagemakerPipeline", "api_error", err)
+		return nil, err
+	}
+	return data, nil
+}
+
+func listSageMakerPipelineTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pipelineArn := sageMakerPipelineARN(h.Item)
+
+	// Create client
+	svc, err := SageMakerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sagemaker_pipeline.listSageMakerPipelineTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build the params
+	params := &sagemaker.ListTagsInput{
+		ResourceArn: aws.String(pipelineArn),
+	}
+
+	pagesLeft := true
+	tags := []types.Tag{}
+	for pagesLeft {
+
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		keyTags, err := svc.ListTags(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_sagemaker_pipeline.listSageMakerPipelineTags", "api_error", err)
+			return nil, err
+		}
+		tags = append(tags, keyTags.Tags...)
+
+		if keyTags.NextToken != nil {
+			params.NextToken = keyTags.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return tags, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func sageMakerPipelineARN(item interface{}) string {
+	switch item := item.(type) {
+	case types.PipelineSummary:
+		return *item.PipelineArn
+	case *sagemaker.DescribePipelineOutput:
+		return *item.PipelineArn
+	}
+	return ""
+}
@@ -92,6 +92,11 @@ func tableAwsSecretsManagerSecret(_ context.Context) *plugin.Table {
 				Description: "The most recent date and time that the Secrets Manager rotation process was successfully completed.",
 				Type:        proto.ColumnType_TIMESTAMP,
 			},
+			{
+				Name:        "next_rotation_date",
+				Description: "The next date and time that Secrets Manager will rotate the secret, rounded to the nearest hour. If the secret isn't configured for rotation, this is null.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
 			{
 				Name:        "owning_service",
 				Description: "Returns the name of the service that created the secret.",
@@ -142,6 +147,20 @@ func tableAwsSecretsManagerSecret(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Hydrate:     describeSecretsManagerSecret,
 			},
+			{
+				Name:        "rotation_automatically_after_days",
+				Description: "The number of days between rotations of the secret, if rotation is configured with a number of days rather than a schedule expression.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     describeSecretsManagerSecret,
+				Transform:   transform.FromField("RotationRules.AutomaticallyAfterDays"),
+			},
+			{
+				Name:        "rotation_schedule_expression",
+				Description: "A cron() or rate() expression that defines the schedule for rotating the secret.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeSecretsManagerSecret,
+				Transform:   transform.FromField("RotationRules.ScheduleExpression"),
+			},
 			{
 				Name:        "secret_versions_to_stages",
 				Description: "A list of all of the currently assigned SecretVersionStage staging labels and the SecretVersionId attached to each one.",
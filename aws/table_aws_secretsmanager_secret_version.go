@@ -0,0 +1,210 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+
+	secretsmanagerv1 "github.com/aws/aws-sdk-go/service/secretsmanager"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type secretsManagerSecretVersion struct {
+	SecretId   *string
+	SecretArn  *string
+	SecretName *string
+	types.SecretVersionsListEntry
+}
+
+//// TABLE DEFINITION
+
+func tableAwsSecretsManagerSecretVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_secretsmanager_secret_version",
+		Description: "AWS Secrets Manager Secret Version",
+		List: &plugin.ListConfig{
+			Hydrate: listSecretsManagerSecretVersions,
+			Tags:    map[string]string{"service": "secretsmanager", "action": "ListSecretVersionIds"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "secret_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(secretsmanagerv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "version_id",
+				Description: "The unique version identifier of this version of the secret.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "secret_id",
+				Description: "The ARN or name of the secret the version belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "secret_arn",
+				Description: "The ARN of the secret the version belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "secret_name",
+				Description: "The name of the secret the version belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version_stages",
+				Description: "An array of staging labels that are currently associated with this version of the secret. Versions without any staging labels are deprecated.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "created_date",
+				Description: "The date and time this version of the secret was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_accessed_date",
+				Description: "The date that this version of the secret was last accessed. Note that the resolution of this field is at the date level and does not include the time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "kms_key_ids",
+				Description: "The KMS keys used to encrypt the secret version.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VersionId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecretsManagerSecretVersions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	// Create session
+	svc, err := SecretsManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_secretsmanager_secret_version.listSecretsManagerSecretVersions", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	// The secret can be identified by either its name or its ARN. If it is given,
+	// list its versions directly instead of listing every secret in the region.
+	secretId := d.EqualsQualString("secret_id")
+	if secretId != "" {
+		// A secret ARN belongs to a single region
+		if arn.IsARN(secretId) {
+			secretArn, err := arn.Parse(secretId)
+			if err != nil || secretArn.Region != region {
+				return nil, nil
+			}
+		}
+		_, err := listSecretsManagerSecretVersionsForSecret(ctx, d, svc, secretId, maxLimit)
+		return nil, err
+	}
+
+	input := &secretsmanager.ListSecretsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := secretsmanager.NewListSecretsPaginator(svc, input, func(o *secretsmanager.ListSecretsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_secretsmanager_secret_version.listSecretsManagerSecretVersions", "api_error", err)
+			return nil, err
+		}
+
+		for _, secret := range output.SecretList {
+			done, err := listSecretsManagerSecretVersionsForSecret(ctx, d, svc, *secret.ARN, maxLimit)
+			if err != nil || done {
+				return nil, err
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// listSecretsManagerSecretVersionsForSecret streams the versions of a single
+// secret and reports whether the query needs no more rows.
+func listSecretsManagerSecretVersionsForSecret(ctx context.Context, d *plugin.QueryData, svc *secretsmanager.Client, secretId string, maxLimit int32) (bool, error) {
+	// Deprecated versions are included so that the full version lineage can
+	// be audited. Only version metadata is returned, never the secret value.
+	input := &secretsmanager.ListSecretVersionIdsInput{
+		SecretId:          aws.String(secretId),
+		IncludeDeprecated: aws.Bool(true),
+		MaxResults:        aws.Int32(maxLimit),
+	}
+
+	paginator := secretsmanager.NewListSecretVersionIdsPaginator(svc, input, func(o *secretsmanager.ListSecretVersionIdsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_secretsmanager_secret_version.listSecretsManagerSecretVersionsForSecret", "api_error", err)
+			return false, err
+		}
+
+		for _, version := range output.Versions {
+			d.StreamListItem(ctx, &secretsManagerSecretVersion{
+				SecretId:                aws.String(secretId),
+				SecretArn:               output.ARN,
+				SecretName:              output.Name,
+				SecretVersionsListEntry: version,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -78,6 +78,13 @@ func tableAwsServicecatalogProduct(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("ProductViewSummary.ProductId"),
 			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the product.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProductArn,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "source_product_id",
 				Description: "The source product identifier.",
@@ -78,6 +78,10 @@ func tableAwsServicecatalogProvisionedProduct(_ context.Context) *plugin.Table {
 				Func: getServiceCatalogProvisionedProduct,
 				Tags: map[string]string{"service": "servicecatalog", "action": "DescribeProvisionedProduct"},
 			},
+			{
+				Func: getServiceCatalogProvisionedProductPhysicalId,
+				Tags: map[string]string{"service": "servicecatalog", "action": "SearchProvisionedProducts"},
+			},
 		},
 		GetMatrixItemFunc: SupportedRegionMatrix(servicecatalogv1.EndpointsID),
 		Columns: awsRegionalColumns([]*plugin.Column{
@@ -172,6 +176,13 @@ func tableAwsServicecatalogProvisionedProduct(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("ProvisionedProductDetail.Type"),
 			},
+			{
+				Name:        "physical_id",
+				Description: "The assigned identifier for the resource, such as an EC2 instance ID or an S3 bucket name.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getServiceCatalogProvisionedProductPhysicalId,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "cloud_watch_dashboards",
 				Description: "Any CloudWatch dashboards that were created when provisioning the product.",
@@ -195,6 +206,13 @@ func tableAwsServicecatalogProvisionedProduct(_ context.Context) *plugin.Table {
 	}
 }
 
+// SearchProvisionedProducts returns the physical ID of each provisioned product,
+// which DescribeProvisionedProduct does not, so list rows carry it alongside the detail.
+type serviceCatalogProvisionedProductInfo struct {
+	PhysicalId *string
+	*servicecatalog.DescribeProvisionedProductOutput
+}
+
 //// LIST FUNCTION
 
 func listServiceCatalogProvisionedProducts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
@@ -220,7 +238,8 @@ func listServiceCatalogProvisionedProducts(ctx context.Context, d *plugin.QueryD
 	}
 
 	input := &servicecatalog.SearchProvisionedProductsInput{
-		PageSize: maxLimit,
+		AccessLevelFilter: serviceCatalogProvisionedProductAccessLevelFilter(),
+		PageSize:          maxLimit,
 	}
 
 	if d.EqualsQualString("accept_language") != "" {
@@ -245,21 +264,24 @@ func listServiceCatalogProvisionedProducts(ctx context.Context, d *plugin.QueryD
 		}
 
 		for _, item := range output.ProvisionedProducts {
-			d.StreamListItem(ctx, &servicecatalog.DescribeProvisionedProductOutput{
-				ProvisionedProductDetail: &types.ProvisionedProductDetail{
-					Arn:                                item.Arn,
-					CreatedTime:                        item.CreatedTime,
-					Name:                               item.Name,
-					Id:                                 item.Id,
-					IdempotencyToken:                   item.IdempotencyToken,
-					LastProvisioningRecordId:           item.LastProvisioningRecordId,
-					LastRecordId:                       item.LastRecordId,
-					LastSuccessfulProvisioningRecordId: item.LastSuccessfulProvisioningRecordId,
-					ProductId:                          item.ProductId,
-					ProvisioningArtifactId:             item.ProvisioningArtifactId,
-					Status:                             item.Status,
-					StatusMessage:                      item.StatusMessage,
-					Type:                               item.Type,
+			d.StreamListItem(ctx, &serviceCatalogProvisionedProductInfo{
+				PhysicalId: item.PhysicalId,
+				DescribeProvisionedProductOutput: &servicecatalog.DescribeProvisionedProductOutput{
+					ProvisionedProductDetail: &types.ProvisionedProductDetail{
+						Arn:                                item.Arn,
+						CreatedTime:                        item.CreatedTime,
+						Name:                               item.Name,
+						Id:                                 item.Id,
+						IdempotencyToken:                   item.IdempotencyToken,
+						LastProvisioningRecordId:           item.LastProvisioningRecordId,
+						LastRecordId:                       item.LastRecordId,
+						LastSuccessfulProvisioningRecordId: item.LastSuccessfulProvisioningRecordId,
+						ProductId:                          item.ProductId,
+						ProvisioningArtifactId:             item.ProvisioningArtifactId,
+						Status:                             item.Status,
+						StatusMessage:                      item.StatusMessage,
+						Type:                               item.Type,
+					},
 				},
 			})
 
@@ -315,8 +337,65 @@ func getServiceCatalogProvisionedProduct(ctx context.Context, d *plugin.QueryDat
 	return op, nil
 }
 
+// List rows already carry the physical ID. DescribeProvisionedProduct does not
+// return it, so on the get path look the provisioned product up through
+// SearchProvisionedProducts instead.
+func getServiceCatalogProvisionedProductPhysicalId(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var product *servicecatalog.DescribeProvisionedProductOutput
+	switch item := h.Item.(type) {
+	case *serviceCatalogProvisionedProductInfo:
+		return item.PhysicalId, nil
+	case *servicecatalog.DescribeProvisionedProductOutput:
+		product = item
+	default:
+		return nil, nil
+	}
+	if product.ProvisionedProductDetail == nil || product.ProvisionedProductDetail.Id == nil {
+		return nil, nil
+	}
+	id := *product.ProvisionedProductDetail.Id
+
+	// Create client
+	svc, err := ServiceCatalogClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_servicecatalog_provisioned_product.getServiceCatalogProvisionedProductPhysicalId", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &servicecatalog.SearchProvisionedProductsInput{
+		AccessLevelFilter: serviceCatalogProvisionedProductAccessLevelFilter(),
+		Filters:           map[string][]string{"SearchQuery": {"id:" + id}},
+	}
+
+	op, err := svc.SearchProvisionedProducts(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_servicecatalog_provisioned_product.getServiceCatalogProvisionedProductPhysicalId", "api_error", err)
+		return nil, err
+	}
+
+	for _, item := range op.ProvisionedProducts {
+		if item.Id != nil && *item.Id == id {
+			return item.PhysicalId, nil
+		}
+	}
+	return nil, nil
+}
+
 //// UTILITY FUNCTIONS
 
+// The list and the get path both search at the User access level, which is the
+// SearchProvisionedProducts default, so that they see the same provisioned products.
+func serviceCatalogProvisionedProductAccessLevelFilter() *types.AccessLevelFilter {
+	return &types.AccessLevelFilter{
+		Key:   types.AccessLevelFilterKeyUser,
+		Value: aws.String("self"),
+	}
+}
+
 // Buid servicecatalog product list call filter param
 
 func buildServiceCatalogProvisionedProductFilter(ctx context.Context, quals plugin.KeyColumnQualMap) map[string][]string {
@@ -0,0 +1,250 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+
+	sesv2v1 "github.com/aws/aws-sdk-go/service/sesv2"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsSESConfigurationSet(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ses_configuration_set",
+		Description: "AWS SES Configuration Set",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getSESConfigurationSet,
+			Tags:    map[string]string{"service": "ses", "action": "GetConfigurationSet"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSESConfigurationSets,
+			Tags:    map[string]string{"service": "ses", "action": "ListConfigurationSets"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getSESConfigurationSet,
+				Tags: map[string]string{"service": "ses", "action": "GetConfigurationSet"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(sesv2v1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the configuration set.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigurationSetName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The ARN of the configuration set.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSESConfigurationSetARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "delivery_options",
+				Description: "An object that defines the dedicated IP pool that is used to send emails that you send using the configuration set, and whether messages must be delivered over TLS.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESConfigurationSet,
+			},
+			{
+				Name:        "reputation_options",
+				Description: "An object that defines whether or not Amazon SES collects reputation metrics for the emails that you send that use the configuration set.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESConfigurationSet,
+			},
+			{
+				Name:        "sending_options",
+				Description: "An object that defines whether or not Amazon SES can send email that you send using the configuration set.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESConfigurationSet,
+			},
+			{
+				Name:        "suppression_options",
+				Description: "An object that contains information about the suppression list preferences for your account.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESConfigurationSet,
+			},
+			{
+				Name:        "tracking_options",
+				Description: "An object that defines the open and click tracking options for emails that you send using the configuration set.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESConfigurationSet,
+			},
+			{
+				Name:        "vdm_options",
+				Description: "An object that contains information about the Virtual Deliverability Manager (VDM) settings that apply to the configuration set.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESConfigurationSet,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the configuration set.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESConfigurationSet,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigurationSetName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESConfigurationSet,
+				Transform:   transform.FromField("Tags").Transform(sesV2TagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESConfigurationSetARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSESConfigurationSets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := SESV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ses_configuration_set.listSESConfigurationSets", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	input := &sesv2.ListConfigurationSetsInput{
+		PageSize: aws.Int32(1000),
+	}
+
+	// Limiting the results
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < *input.PageSize {
+			if limit < 1 {
+				input.PageSize = aws.Int32(1)
+			} else {
+				input.PageSize = aws.Int32(limit)
+			}
+		}
+	}
+
+	// List call
+	paginator := sesv2.NewListConfigurationSetsPaginator(svc, input, func(o *sesv2.ListConfigurationSetsPaginatorOptions) {
+		o.Limit = *input.PageSize
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ses_configuration_set.listSESConfigurationSets", "api_error", err)
+			return nil, err
+		}
+
+		for _, name := range output.ConfigurationSets {
+			d.StreamListItem(ctx, &sesv2.GetConfigurationSetOutput{
+				ConfigurationSetName: aws.String(name),
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSESConfigurationSet(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name string
+	if h.Item != nil {
+		name = *h.Item.(*sesv2.GetConfigurationSetOutput).ConfigurationSetName
+	} else {
+		name = d.EqualsQualString("name")
+	}
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := SESV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ses_configuration_set.getSESConfigurationSet", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	input := &sesv2.GetConfigurationSetInput{
+		ConfigurationSetName: aws.String(name),
+	}
+	result, err := svc.GetConfigurationSet(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ses_configuration_set.getSESConfigurationSet", "api_error", err)
+		return nil, err
+	}
+	return result, nil
+}
+
+func getSESConfigurationSetARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := *h.Item.(*sesv2.GetConfigurationSetOutput).ConfigurationSetName
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	c, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ses_configuration_set.getSESConfigurationSetARN", "api_error", err)
+		return nil, err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+	arn := "arn:" + commonColumnData.Partition + ":ses:" + region + ":" + commonColumnData.AccountId + ":configuration-set/" + name
+	return arn, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func sesV2TagListToTurbotTags(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]types.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	// Mapping the resource tags inside turbotTags
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,224 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+
+	sesv2v1 "github.com/aws/aws-sdk-go/service/sesv2"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsSESContactList(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ses_contact_list",
+		Description: "AWS SES Contact List",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getSESContactList,
+			Tags:    map[string]string{"service": "ses", "action": "GetContactList"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSESContactLists,
+			Tags:    map[string]string{"service": "ses", "action": "ListContactLists"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getSESContactList,
+				Tags: map[string]string{"service": "ses", "action": "GetContactList"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(sesv2v1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the contact list.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ContactListName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The ARN of the contact list.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSESContactListARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "description",
+				Description: "A description of what the contact list is about.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSESContactList,
+			},
+			{
+				Name:        "created_timestamp",
+				Description: "A timestamp noting when the contact list was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getSESContactList,
+			},
+			{
+				Name:        "last_updated_timestamp",
+				Description: "A timestamp noting the last time the contact list was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "topics",
+				Description: "An interest group, theme, or label within a list. A contact list can have multiple topics.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESContactList,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the contact list.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESContactList,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ContactListName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESContactList,
+				Transform:   transform.FromField("Tags").Transform(sesV2TagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESContactListARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSESContactLists(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := SESV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ses_contact_list.listSESContactLists", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	input := &sesv2.ListContactListsInput{
+		PageSize: aws.Int32(1000),
+	}
+
+	// Limiting the results
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < *input.PageSize {
+			if limit < 1 {
+				input.PageSize = aws.Int32(1)
+			} else {
+				input.PageSize = aws.Int32(limit)
+			}
+		}
+	}
+
+	// List call
+	paginator := sesv2.NewListContactListsPaginator(svc, input, func(o *sesv2.ListContactListsPaginatorOptions) {
+		o.Limit = *input.PageSize
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ses_contact_list.listSESContactLists", "api_error", err)
+			return nil, err
+		}
+
+		for _, contactList := range output.ContactLists {
+			d.StreamListItem(ctx, contactList)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSESContactList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name string
+	if h.Item != nil {
+		name = *h.Item.(types.ContactList).ContactListName
+	} else {
+		name = d.EqualsQualString("name")
+	}
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := SESV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ses_contact_list.getSESContactList", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	input := &sesv2.GetContactListInput{
+		ContactListName: aws.String(name),
+	}
+	result, err := svc.GetContactList(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ses_contact_list.getSESContactList", "api_error", err)
+		return nil, err
+	}
+	return result, nil
+}
+
+func getSESContactListARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name string
+	switch item := h.Item.(type) {
+	case types.ContactList:
+		name = *item.ContactListName
+	case *sesv2.GetContactListOutput:
+		name = *item.ContactListName
+	}
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	c, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ses_contact_list.getSESContactListARN", "api_error", err)
+		return nil, err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+	arn := "arn:" + commonColumnData.Partition + ":ses:" + region + ":" + commonColumnData.AccountId + ":contact-list/" + name
+	return arn, nil
+}
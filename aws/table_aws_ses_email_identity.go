@@ -6,6 +6,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ses"
 	"github.com/aws/aws-sdk-go-v2/service/ses/types"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	sesv2types "github.com/aws/aws-sdk-go-v2/service/sesv2/types"
 
 	sesv1 "github.com/aws/aws-sdk-go/service/ses"
 
@@ -18,6 +20,14 @@ func tableAwsSESEmailIdentity(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:        "aws_ses_email_identity",
 		Description: "AWS SES Email Identity",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("identity"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getSESEmailIdentity,
+			Tags:    map[string]string{"service": "ses", "action": "GetEmailIdentity"},
+		},
 		List: &plugin.ListConfig{
 			Hydrate: listSESEmailIdentities,
 			Tags:    map[string]string{"service": "ses", "action": "ListIdentities"},
@@ -31,6 +41,10 @@ func tableAwsSESEmailIdentity(_ context.Context) *plugin.Table {
 				Func: getSESIdentityNotificationAttributes,
 				Tags: map[string]string{"service": "ses", "action": "GetIdentityNotificationAttributes"},
 			},
+			{
+				Func: getSESV2EmailIdentity,
+				Tags: map[string]string{"service": "ses", "action": "GetEmailIdentity"},
+			},
 		},
 		GetMatrixItemFunc: SupportedRegionMatrix(sesv1.EndpointsID),
 		Columns: awsRegionalColumns([]*plugin.Column{
@@ -38,7 +52,7 @@ func tableAwsSESEmailIdentity(_ context.Context) *plugin.Table {
 				Name:        "identity",
 				Description: "The email identity.",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromValue(),
+				Transform:   transform.FromField("Identity"),
 			},
 			{
 				Name:        "arn",
@@ -60,13 +74,69 @@ func tableAwsSESEmailIdentity(_ context.Context) *plugin.Table {
 				Hydrate:     getSESIdentityNotificationAttributes,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "identity_type",
+				Description: "The email identity type. Can be EMAIL_ADDRESS, DOMAIN or MANAGED_DOMAIN.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSESV2EmailIdentity,
+			},
+			{
+				Name:        "verified_for_sending_status",
+				Description: "Specifies whether or not the identity is verified. You can only send email from verified email addresses or domains.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getSESV2EmailIdentity,
+			},
+			{
+				Name:        "feedback_forwarding_status",
+				Description: "Indicates whether or not Amazon SES forwards bounce and complaint notifications by email.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getSESV2EmailIdentity,
+			},
+			{
+				Name:        "configuration_set_name",
+				Description: "The configuration set used by default when sending from this identity.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSESV2EmailIdentity,
+			},
+			{
+				Name:        "dkim_attributes",
+				Description: "An object that contains information about the DKIM attributes for the identity.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESV2EmailIdentity,
+			},
+			{
+				Name:        "mail_from_attributes",
+				Description: "An object that contains information about the Mail-From attributes for the email identity.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESV2EmailIdentity,
+			},
+			{
+				Name:        "policies",
+				Description: "A map of policy names to policies.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESV2EmailIdentity,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the email identity.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESV2EmailIdentity,
+				Transform:   transform.FromField("Tags"),
+			},
 
 			// Standard columns for all tables
 			{
 				Name:        "title",
 				Description: resourceInterfaceDescription("title"),
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromValue(),
+				Transform:   transform.FromField("Identity"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESV2EmailIdentity,
+				Transform:   transform.FromField("Tags").Transform(sesV2TagListToTurbotTags),
 			},
 			{
 				Name:        "akas",
@@ -79,6 +149,13 @@ func tableAwsSESEmailIdentity(_ context.Context) *plugin.Table {
 	}
 }
 
+// The get call already fetches the SES v2 email identity to check its type, so
+// it is carried with the row to save getSESV2EmailIdentity a second call.
+type sesEmailIdentityInfo struct {
+	Identity      string
+	EmailIdentity *sesv2.GetEmailIdentityOutput
+}
+
 //// LIST FUNCTION
 
 func listSESEmailIdentities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
@@ -128,7 +205,9 @@ func listSESEmailIdentities(ctx context.Context, d *plugin.QueryData, _ *plugin.
 		}
 
 		for _, identity := range output.Identities {
-			d.StreamListItem(ctx, identity)
+			d.StreamListItem(ctx, &sesEmailIdentityInfo{
+				Identity: identity,
+			})
 
 			// Context can be cancelled due to manual cancellation or the limit has been hit
 			if d.RowsRemaining(ctx) == 0 {
@@ -142,8 +221,46 @@ func listSESEmailIdentities(ctx context.Context, d *plugin.QueryData, _ *plugin.
 
 //// HYDRATE FUNCTIONS
 
+func getSESEmailIdentity(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	identity := d.EqualsQualString("identity")
+
+	// Empty check
+	if identity == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := SESV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ses_email_identity.getSESEmailIdentity", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	input := &sesv2.GetEmailIdentityInput{
+		EmailIdentity: aws.String(identity),
+	}
+	result, err := svc.GetEmailIdentity(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ses_email_identity.getSESEmailIdentity", "api_error", err)
+		return nil, err
+	}
+
+	// Domain identities are returned by the aws_ses_domain_identity table
+	if result.IdentityType != sesv2types.IdentityTypeEmailAddress {
+		return nil, nil
+	}
+	return &sesEmailIdentityInfo{
+		Identity:      identity,
+		EmailIdentity: result,
+	}, nil
+}
+
 func getSESIdentityVerificationAttributes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	identity := h.Item.(string)
+	identity := sesIdentityName(h.Item)
 	identities := []string{identity}
 
 	// Create Session
@@ -169,7 +286,7 @@ func getSESIdentityVerificationAttributes(ctx context.Context, d *plugin.QueryDa
 }
 
 func getSESIdentityNotificationAttributes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	identity := h.Item.(string)
+	identity := sesIdentityName(h.Item)
 	identities := []string{identity}
 
 	// Create Session
@@ -194,8 +311,39 @@ func getSESIdentityNotificationAttributes(ctx context.Context, d *plugin.QueryDa
 	return result.NotificationAttributes[identity], err
 }
 
+func getSESV2EmailIdentity(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	info := h.Item.(*sesEmailIdentityInfo)
+
+	// The get call has already fetched the email identity
+	if info.EmailIdentity != nil {
+		return info.EmailIdentity, nil
+	}
+	identity := info.Identity
+
+	// Create Session
+	svc, err := SESV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ses_email_identity.getSESV2EmailIdentity", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	input := &sesv2.GetEmailIdentityInput{
+		EmailIdentity: aws.String(identity),
+	}
+	result, err := svc.GetEmailIdentity(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ses_email_identity.getSESV2EmailIdentity", "api_error", err)
+		return nil, err
+	}
+	return result, nil
+}
+
 func getSESIdentityARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	identity := h.Item.(string)
+	identity := sesIdentityName(h.Item)
 	region := d.EqualsQualString(matrixKeyRegion)
 
 	c, err := getCommonColumns(ctx, d, h)
@@ -207,3 +355,17 @@ func getSESIdentityARN(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	arn := "arn:" + commonColumnData.Partition + ":ses:" + region + ":" + commonColumnData.AccountId + ":identity/" + identity
 	return arn, nil
 }
+
+//// UTILITY FUNCTIONS
+
+// The email identity table streams sesEmailIdentityInfo rows, while the domain
+// identity table streams the identity name itself.
+func sesIdentityName(item interface{}) string {
+	switch item := item.(type) {
+	case *sesEmailIdentityInfo:
+		return item.Identity
+	case string:
+		return item
+	}
+	return ""
+}
@@ -97,6 +97,26 @@ func tableAwsTransferUser(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Hydrate:     getTransferUser,
 			},
+			{
+				Name:        "policy",
+				Description: "The session policy applied to the user, scoping down the permissions granted by the role.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getTransferUser,
+				Transform:   transform.FromField("Policy").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "policy_std",
+				Description: "Contains the session policy in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getTransferUser,
+				Transform:   transform.FromField("Policy").Transform(unescape).Transform(policyToCanonical),
+			},
+			{
+				Name:        "posix_profile",
+				Description: "The full POSIX identity, including user ID (Uid), group ID (Gid), and any secondary groups IDs (SecondaryGids), that controls the user's access to Amazon EFS file systems.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getTransferUser,
+			},
 
 			// Steampipe standard columns
 			{
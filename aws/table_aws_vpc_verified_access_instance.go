@@ -47,6 +47,11 @@ func tableAwsVpcVerifiedAccessInstance(_ context.Context) *plugin.Table {
 				Description: "A description for the AWS Verified Access instance.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "fips_enabled",
+				Description: "Indicates whether support for Federal Information Processing Standards (FIPS) is enabled on the instance.",
+				Type:        proto.ColumnType_BOOL,
+			},
 			{
 				Name:        "last_updated_time",
 				Description: "The last updated time.",
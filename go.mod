@@ -26,6 +26,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/auditmanager v1.32.4
 	github.com/aws/aws-sdk-go-v2/service/autoscaling v1.40.5
 	github.com/aws/aws-sdk-go-v2/service/backup v1.34.2
+	github.com/aws/aws-sdk-go-v2/service/batch v1.37.4
 	github.com/aws/aws-sdk-go-v2/service/cloudcontrol v1.18.4
 	github.com/aws/aws-sdk-go-v2/service/cloudformation v1.49.0
 	github.com/aws/aws-sdk-go-v2/service/cloudfront v1.35.4
@@ -74,6 +75,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/health v1.24.4
 	github.com/aws/aws-sdk-go-v2/service/iam v1.31.4
 	github.com/aws/aws-sdk-go-v2/service/identitystore v1.23.5
+	github.com/aws/aws-sdk-go-v2/service/imagebuilder v1.33.1
 	github.com/aws/aws-sdk-go-v2/service/inspector v1.21.4
 	github.com/aws/aws-sdk-go-v2/service/inspector2 v1.24.4
 	github.com/aws/aws-sdk-go-v2/service/iot v1.53.3
@@ -120,6 +122,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/servicediscovery v1.29.5
 	github.com/aws/aws-sdk-go-v2/service/servicequotas v1.21.4
 	github.com/aws/aws-sdk-go-v2/service/ses v1.22.4
+	github.com/aws/aws-sdk-go-v2/service/sesv2 v1.29.4
 	github.com/aws/aws-sdk-go-v2/service/sfn v1.26.4
 	github.com/aws/aws-sdk-go-v2/service/shield v1.25.7
 	github.com/aws/aws-sdk-go-v2/service/simspaceweaver v1.10.4